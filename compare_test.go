@@ -0,0 +1,128 @@
+package generic
+
+import (
+	"math"
+	"sort"
+	"testing"
+	"testing/quick"
+)
+
+// QuickSort sorts the number slices by radix sort, so QuickSortBy is used to test the compare function
+func TestSliceQuickSort_Overflow(t *testing.T) {
+	uint64s := []uint64{math.MaxUint64, 0, 1, math.MaxUint64 - 1}
+	if err := Slice(&uint64s).QuickSortBy(defaultCompareFuncName); err != nil {
+		t.Fatal("Failed to quick sort uint64 slice! error: ", err)
+	}
+	if uint64s[0] != 0 || uint64s[1] != 1 || uint64s[2] != math.MaxUint64-1 || uint64s[3] != math.MaxUint64 {
+		t.Fatal("After quick sort uint64 slice with large values, the elements should be ordered! actual: ", uint64s)
+	}
+
+	int64s := []int64{math.MaxInt64, math.MinInt64, 0, -1, math.MinInt64 + 1}
+	if err := Slice(&int64s).QuickSortBy(defaultCompareFuncName); err != nil {
+		t.Fatal("Failed to quick sort int64 slice! error: ", err)
+	}
+	if int64s[0] != math.MinInt64 || int64s[1] != math.MinInt64+1 || int64s[2] != -1 || int64s[3] != 0 || int64s[4] != math.MaxInt64 {
+		t.Fatal("After quick sort int64 slice with large values, the elements should be ordered! actual: ", int64s)
+	}
+
+	uint8s := []uint8{1, 0, 255}
+	if err := Slice(&uint8s).QuickSortBy(defaultCompareFuncName); err != nil {
+		t.Fatal("Failed to quick sort uint8 slice! error: ", err)
+	}
+	if uint8s[0] != 0 || uint8s[1] != 1 || uint8s[2] != 255 {
+		t.Fatal("After quick sort uint8 slice, the elements should be ordered! actual: ", uint8s)
+	}
+}
+
+func TestSliceQuickSort_NaN(t *testing.T) {
+	nan := math.NaN()
+	values := []float64{3, nan, math.Inf(1), -1, nan, math.Inf(-1)}
+	if err := Slice(&values).QuickSortBy(defaultCompareFuncName); err != nil {
+		t.Fatal("Failed to quick sort float64 slice contains NaN! error: ", err)
+	}
+	if !math.IsNaN(values[0]) || !math.IsNaN(values[1]) || values[2] != math.Inf(-1) ||
+		values[3] != -1 || values[4] != 3 || values[5] != math.Inf(1) {
+		t.Fatal("After quick sort float64 slice contains NaN, NaN should be first and the others should be ordered! actual: ", values)
+	}
+}
+
+func TestSliceQuickSort_PropertyInt64(t *testing.T) {
+	property := func(values []int64) bool {
+		expected := append([]int64{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		if err := Slice(&values).QuickSortBy(defaultCompareFuncName); err != nil {
+			return false
+		}
+		for i := range values {
+			if values[i] != expected[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal("Quick sort int64 slice should be same as sort package! error: ", err)
+	}
+}
+
+func TestSliceQuickSort_PropertyUint64(t *testing.T) {
+	property := func(values []uint64) bool {
+		expected := append([]uint64{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		if err := Slice(&values).QuickSortBy(defaultCompareFuncName); err != nil {
+			return false
+		}
+		for i := range values {
+			if values[i] != expected[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal("Quick sort uint64 slice should be same as sort package! error: ", err)
+	}
+}
+
+func TestSliceStableSort_PropertyInt8(t *testing.T) {
+	property := func(values []int8) bool {
+		expected := append([]int8{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		if err := Slice(&values).StableSort(); err != nil {
+			return false
+		}
+		for i := range values {
+			if values[i] != expected[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal("Stable sort int8 slice should be same as sort package! error: ", err)
+	}
+}
+
+func TestSliceQuickSort_PropertyFloat64(t *testing.T) {
+	property := func(values []float64, nanIndexes []uint8) bool {
+		for _, index := range nanIndexes {
+			if len(values) > 0 {
+				values[int(index)%len(values)] = math.NaN()
+			}
+		}
+		expected := append([]float64{}, values...)
+		sort.Float64s(expected)
+		if err := Slice(&values).QuickSortBy(defaultCompareFuncName); err != nil {
+			return false
+		}
+		for i := range values {
+			if values[i] != expected[i] && !(math.IsNaN(values[i]) && math.IsNaN(expected[i])) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal("Quick sort float64 slice should be same as sort package! error: ", err)
+	}
+}
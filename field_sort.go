@@ -0,0 +1,120 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// sort struct slice by the fields one after another by quick sort algorithm.
+// The field with prefix "-" is sorted in descending order. such as
+//
+//	Slice(&employees).SortByFields("Department", "-Age", "Name")
+//
+// The slice can be struct slice or struct pointer slice, and the fields should be exported.
+// The field can be any type supported by QuickSort, and the field promoted through nil embedded pointer is ordered like nil pointer.
+// Use SortWith with SortOptions.UnexportedFields to sort by unexported fields.
+func (s *slice) SortByFields(fields ...string) error {
+	if len(fields) == 0 {
+		return errors.New("no sort field!")
+	}
+
+	return s.SortWith(SortOptions{Fields: fields})
+}
+
+// the field to compare elements
+type sortField struct {
+	index       []int
+	compareFunc compareFunc
+	// the field promoted through nil embedded pointer is ordered like nil pointer
+	nilsLast bool
+}
+
+// return the function to compare structs by opts.Fields
+func (opts SortOptions) compareFieldsFunc(structType reflect.Type) (compareFunc, error) {
+	if structType.Kind() != reflect.Struct {
+		return nil, errors.New("sort by fields should be struct slice!")
+	}
+
+	sortFields := []sortField{}
+	for _, fieldName := range opts.Fields {
+		descending := false
+		if strings.HasPrefix(fieldName, "-") {
+			descending = true
+			fieldName = fieldName[1:]
+		} else if strings.HasPrefix(fieldName, "+") {
+			fieldName = fieldName[1:]
+		}
+
+		field, ok := structType.FieldByName(fieldName)
+		if !ok {
+			return nil, errors.New("no field: " + fieldName)
+		}
+		compareFunc, err := opts.fieldCompareFunc(field, descending)
+		if err != nil {
+			return nil, err
+		}
+		sortFields = append(sortFields, sortField{field.Index, compareFunc, opts.NilsLast})
+	}
+
+	return compareByFields(sortFields), nil
+}
+
+// return the function to compare values of the field
+func (opts SortOptions) fieldCompareFunc(field reflect.StructField, descending bool) (compareFunc, error) {
+	if field.PkgPath != "" {
+		if !opts.UnexportedFields {
+			return nil, errors.New("unexported field: " + field.Name)
+		}
+
+		// the methods of unexported field can't be called by reflect
+		valueType := field.Type
+		if valueType.Kind() == reflect.Ptr {
+			valueType = valueType.Elem()
+		}
+		if valueType.Kind() == reflect.Struct || hasMethod(valueType, opts.compareFuncName()) || hasMethod(valueType, lessFuncName) {
+			return nil, errors.New("unexported field can't be compared by compare function: " + field.Name)
+		}
+	}
+
+	fieldOpts := SortOptions{
+		Descending:      descending,
+		NilsLast:        opts.NilsLast,
+		CompareFuncName: opts.CompareFuncName,
+		StringOrder:     opts.StringOrder,
+	}
+	compareFunc, err := fieldOpts.compareFunc(field.Type)
+	if err != nil {
+		return nil, errors.New("field " + field.Name + ": " + err.Error())
+	}
+	return compareFunc, nil
+}
+
+// make a compareFunc which compares structs by the fields one after another
+func compareByFields(sortFields []sortField) compareFunc {
+	return func(val1, val2 reflect.Value) int {
+		for _, field := range sortFields {
+			fieldValue1, err1 := val1.FieldByIndexErr(field.index)
+			fieldValue2, err2 := val2.FieldByIndexErr(field.index)
+			if err1 != nil && err2 != nil {
+				continue
+			}
+			if err1 != nil || err2 != nil {
+				result := 1
+				if err1 != nil {
+					result = -1
+				}
+				if field.nilsLast {
+					result = -result
+				}
+				return result
+			}
+
+			result := field.compareFunc(fieldValue1, fieldValue2)
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"testing"
+)
+
+type employee struct {
+	Department string
+	Age        int
+	Name       string
+	Manager    *employee
+	salary     float64
+}
+
+func TestSliceSortByFields(t *testing.T) {
+	employees := []employee{
+		{Department: "sales", Age: 30, Name: "b"},
+		{Department: "dev", Age: 25, Name: "c"},
+		{Department: "sales", Age: 30, Name: "a"},
+		{Department: "dev", Age: 40, Name: "d"},
+		{Department: "sales", Age: 35, Name: "e"},
+	}
+
+	if err := Slice(&employees).SortByFields("Department", "-Age", "Name"); err != nil {
+		t.Fatal("Failed to sort struct slice by fields! error: ", err)
+	}
+	expected := []string{"d", "c", "e", "a", "b"}
+	for i := range expected {
+		if employees[i].Name != expected[i] {
+			t.Fatal("After sort struct slice by fields, the elements should be ordered! actual: ", employees)
+		}
+	}
+}
+
+func TestSliceSortByFields_Pointer(t *testing.T) {
+	boss := &employee{Name: "boss", Age: 50}
+	employees := []*employee{
+		{Name: "b", Age: 30, Manager: boss},
+		nil,
+		{Name: "a", Age: 30},
+	}
+
+	if err := Slice(&employees).SortByFields("Age", "Manager"); err == nil {
+		t.Fatal("It should be error when the struct field doesn't have compare function!")
+	}
+	if err := Slice(&employees).SortWith(SortOptions{Fields: []string{"-Name"}, NilsLast: true}); err != nil {
+		t.Fatal("Failed to sort struct pointer slice by fields! error: ", err)
+	}
+	if employees[0].Name != "b" || employees[1].Name != "a" || employees[2] != nil {
+		t.Fatal("After sort struct pointer slice by fields, the elements should be ordered! actual: ", employees)
+	}
+}
+
+func TestSliceSortByFields_Unexported(t *testing.T) {
+	employees := []employee{{Name: "a", salary: 300}, {Name: "b", salary: 100}, {Name: "c", salary: 200}}
+	if err := Slice(&employees).SortByFields("salary"); err == nil {
+		t.Fatal("It should be error when sort by unexported field without option!")
+	}
+
+	if err := Slice(&employees).SortWith(SortOptions{Fields: []string{"salary"}, UnexportedFields: true}); err != nil {
+		t.Fatal("Failed to sort struct slice by unexported field! error: ", err)
+	}
+	if employees[0].Name != "b" || employees[1].Name != "c" || employees[2].Name != "a" {
+		t.Fatal("After sort struct slice by unexported field, the elements should be ordered! actual: ", employees)
+	}
+
+	if err := Slice(&employees).SortByFields("Salary"); err == nil {
+		t.Fatal("It should be error when the field doesn't exist!")
+	}
+	if err := Slice(&employees).SortByFields(); err == nil {
+		t.Fatal("It should be error when there is no field!")
+	}
+
+	values := []int{2, 1}
+	if err := Slice(&values).SortByFields("Age"); err == nil {
+		t.Fatal("It should be error when the slice is not struct slice!")
+	}
+}
+
+type person struct {
+	Age int
+}
+
+type member struct {
+	*person
+	Name string
+}
+
+func TestSliceSortByFields_NilEmbedded(t *testing.T) {
+	members := []member{{&person{30}, "a"}, {nil, "b"}, {&person{20}, "c"}, {nil, "d"}}
+	if err := Slice(&members).SortByFields("Age", "Name"); err != nil {
+		t.Fatal("Failed to sort struct slice by field promoted through nil embedded pointer! error: ", err)
+	}
+	if members[0].Name != "b" || members[1].Name != "d" || members[2].Name != "c" || members[3].Name != "a" {
+		t.Fatal("After sort by fields, the nil embedded pointer should be ordered first! actual: ", members)
+	}
+
+	if err := Slice(&members).SortWith(SortOptions{Fields: []string{"-Age"}, NilsLast: true}); err != nil {
+		t.Fatal("Failed to sort struct slice by field promoted through nil embedded pointer with nils last! error: ", err)
+	}
+	if members[0].Name != "a" || members[1].Name != "c" || members[2].person != nil || members[3].person != nil {
+		t.Fatal("After sort by fields with nils last, the nil embedded pointer should be ordered last! actual: ", members)
+	}
+}
@@ -0,0 +1,70 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+// Append elements to the end of slice.
+// The elements should be assignable to the element type of slice, nil is the zero value of pointer, interface, map, slice, chan and func.
+func (s *slice) Append(elems ...interface{}) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	return s.Splice(reflect.ValueOf(s.slicePtr).Elem().Len(), 0, elems...)
+}
+
+// Insert elements at the beginning of slice, the order of elements is kept.
+func (s *slice) Prepend(elems ...interface{}) error {
+	return s.Splice(0, 0, elems...)
+}
+
+// Insert elements before the element at index of slice, index can be the length of slice to append elements.
+func (s *slice) InsertAt(index int, elems ...interface{}) error {
+	return s.Splice(index, 0, elems...)
+}
+
+// Remove deleteCount elements from start of slice, and insert elements at start, like the splice of JavaScript.
+// All elements are checked before changing, so the slice is not changed if any element type mismatches.
+// The vacated tail of slice is zeroed when the slice becomes shorter.
+func (s *slice) Splice(start, deleteCount int, elems ...interface{}) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	length := sliceValue.Len()
+	if start < 0 || start > length {
+		return errors.New("index out of range!")
+	}
+	if deleteCount < 0 || deleteCount > length-start {
+		return errors.New("deleteCount out of range!")
+	}
+
+	elemType := sliceValue.Type().Elem()
+	elemValues := make([]reflect.Value, len(elems))
+	for i, elem := range elems {
+		elemValues[i], err = checkElem(elemType, elem)
+		if err != nil {
+			return errors.New("elems[" + strconv.Itoa(i) + "]: " + err.Error())
+		}
+	}
+
+	newLength := length - deleteCount + len(elems)
+	if newLength > length {
+		sliceValue.Set(reflect.AppendSlice(sliceValue, reflect.MakeSlice(sliceValue.Type(), newLength-length, newLength-length)))
+	}
+	// move the tail after the deleted elements once
+	reflect.Copy(sliceValue.Slice(start+len(elems), newLength), sliceValue.Slice(start+deleteCount, length))
+	for i, elemValue := range elemValues {
+		sliceValue.Index(start + i).Set(elemValue)
+	}
+	if newLength < length {
+		shrink(sliceValue, newLength)
+	}
+	return nil
+}
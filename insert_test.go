@@ -0,0 +1,81 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceAppend(t *testing.T) {
+	values := []int{1, 2}
+	if err := Slice(&values).Append(3, 4); err != nil || !equalSlices(values, []int{1, 2, 3, 4}) {
+		t.Fatal("Failed to append elements! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).Append(); err != nil || !equalSlices(values, []int{1, 2, 3, 4}) {
+		t.Fatal("Failed to append no elements! actual: ", values, " error: ", err)
+	}
+
+	if err := Slice(&values).Append(5, "6"); err == nil || !equalSlices(values, []int{1, 2, 3, 4}) {
+		t.Fatal("It should be error and the slice should not be changed when element type mismatches! actual: ", values)
+	}
+	if err := Slice(&values).Append(nil); err == nil {
+		t.Fatal("It should be error when append nil to int slice!")
+	}
+	if err := Slice(values).Append(5); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+
+	students := []*student{}
+	if err := Slice(&students).Append(&student{name: "1"}, nil); err != nil || len(students) != 2 || students[1] != nil {
+		t.Fatal("Failed to append pointers and nil! actual: ", students, " error: ", err)
+	}
+}
+
+func TestSlicePrepend(t *testing.T) {
+	values := []string{"c"}
+	if err := Slice(&values).Prepend("a", "b"); err != nil || len(values) != 3 || values[0] != "a" || values[1] != "b" || values[2] != "c" {
+		t.Fatal("Failed to prepend elements! actual: ", values, " error: ", err)
+	}
+}
+
+func TestSliceInsertAt(t *testing.T) {
+	values := []int{1, 4}
+	if err := Slice(&values).InsertAt(1, 2, 3); err != nil || !equalSlices(values, []int{1, 2, 3, 4}) {
+		t.Fatal("Failed to insert elements at index! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).InsertAt(4, 5); err != nil || !equalSlices(values, []int{1, 2, 3, 4, 5}) {
+		t.Fatal("Failed to insert elements at the end! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).InsertAt(6, 7); err == nil {
+		t.Fatal("It should be error when index is out of range!")
+	}
+	if err := Slice(&values).InsertAt(-1, 0); err == nil {
+		t.Fatal("It should be error when index is negative!")
+	}
+}
+
+func TestSliceSplice(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4}
+	if err := Slice(&values).Splice(1, 3, 9); err != nil || !equalSlices(values, []int{0, 9, 4}) {
+		t.Fatal("Failed to splice with less elements! actual: ", values, " error: ", err)
+	}
+	if tail := values[:5]; tail[3] != 0 || tail[4] != 0 {
+		t.Fatal("After splice, the vacated tail should be zeroed! actual: ", tail)
+	}
+
+	if err := Slice(&values).Splice(1, 1, 1, 2, 3); err != nil || !equalSlices(values, []int{0, 1, 2, 3, 4}) {
+		t.Fatal("Failed to splice with more elements! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).Splice(5, 0, 5); err != nil || !equalSlices(values, []int{0, 1, 2, 3, 4, 5}) {
+		t.Fatal("Failed to splice at the end! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).Splice(0, 6); err != nil || len(values) != 0 {
+		t.Fatal("Failed to splice all elements! actual: ", values, " error: ", err)
+	}
+
+	values = []int{0, 1}
+	if err := Slice(&values).Splice(1, 2); err == nil {
+		t.Fatal("It should be error when deleteCount is out of range!")
+	}
+	if err := Slice(&values).Splice(1, -1); err == nil {
+		t.Fatal("It should be error when deleteCount is negative!")
+	}
+}
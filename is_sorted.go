@@ -0,0 +1,60 @@
+package generic
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Check the slice is sorted in ascending order, using the same comparison rules as QuickSort.
+func (s *slice) IsSorted() (bool, error) {
+	index, err := s.FirstUnsortedIndex()
+	if err != nil {
+		return false, err
+	}
+	return index == -1, nil
+}
+
+// Basicly it is same as IsSorted function.
+// It just give you choice to decide the compare function which is used by struct
+func (s *slice) IsSortedBy(compareFuncName string) (bool, error) {
+	index, err := s.firstUnsortedIndex(compareFuncName)
+	if err != nil {
+		return false, err
+	}
+	return index == -1, nil
+}
+
+// Return the index of the first element which is less than its previous element,
+// return -1 if the slice is sorted in ascending order. It uses the same comparison rules as QuickSort.
+func (s *slice) FirstUnsortedIndex() (int, error) {
+	return s.firstUnsortedIndex(defaultCompareFuncName)
+}
+
+func (s *slice) firstUnsortedIndex(compareFuncName string) (int, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return -1, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	// the slice type which implements sort.Interface is checked by itself, the same as QuickSort
+	if sortable, ok := sliceValue.Interface().(sort.Interface); ok && compareFuncName == defaultCompareFuncName {
+		for index := 1; index < sortable.Len(); index++ {
+			if sortable.Less(index, index-1) {
+				return index, nil
+			}
+		}
+		return -1, nil
+	}
+
+	sliceValue, compareFunc, err := s.prepareSort(compareFuncName)
+	if err != nil {
+		return -1, err
+	}
+	for index := 1; index < sliceValue.Len(); index++ {
+		if compareFunc(sliceValue.Index(index-1), sliceValue.Index(index)) > 0 {
+			return index, nil
+		}
+	}
+	return -1, nil
+}
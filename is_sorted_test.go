@@ -0,0 +1,70 @@
+package generic
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSliceIsSorted(t *testing.T) {
+	values := []int{1, 2, 2, 3}
+	sorted, err := Slice(&values).IsSorted()
+	if err != nil || !sorted {
+		t.Fatal("The ordered slice should be sorted! error: ", err)
+	}
+
+	empty := []int{}
+	sorted, err = Slice(&empty).IsSorted()
+	if err != nil || !sorted {
+		t.Fatal("The empty slice should be sorted! error: ", err)
+	}
+
+	floats := []float64{math.NaN(), -1, 2}
+	sorted, err = Slice(&floats).IsSorted()
+	if err != nil || !sorted {
+		t.Fatal("NaN should be less than other numbers in sorted slice! error: ", err)
+	}
+
+	words := byLength{"a", "ccc", "bb"}
+	sorted, err = Slice(&words).IsSorted()
+	if err != nil || sorted {
+		t.Fatal("The slice implements sort.Interface should be checked by itself! error: ", err)
+	}
+
+	maps := []map[int]int{{}, {}}
+	if sorted, err := Slice(&maps).IsSorted(); err == nil || sorted {
+		t.Fatal("It should be error and not sorted when the type is unsupported!")
+	}
+	if sorted, err := Slice(&maps).IsSortedBy("CompareByAge"); err == nil || sorted {
+		t.Fatal("It should be error and not sorted when the type is unsupported by compare function!")
+	}
+}
+
+func TestSliceIsSortedBy(t *testing.T) {
+	students := []student{{name: "1", age: 11}, {name: "2", age: 15}, {name: "3", age: 14}}
+	sorted, err := Slice(&students).IsSortedBy("CompareByAge")
+	if err != nil || sorted {
+		t.Fatal("The unordered struct slice should not be sorted! error: ", err)
+	}
+
+	if _, err = Slice(&students).IsSortedBy("CompareByName"); err == nil {
+		t.Fatal("It should be error when the compare function doesn't exist!")
+	}
+}
+
+func TestSliceFirstUnsortedIndex(t *testing.T) {
+	values := []string{"a", "b", "d", "c", "e", "a"}
+	index, err := Slice(&values).FirstUnsortedIndex()
+	if err != nil || index != 3 {
+		t.Fatal("Failed to get the first unsorted index! index: ", index, " error: ", err)
+	}
+
+	students := []*student{nil, {name: "1", age: 11}, {name: "2", age: 15}}
+	index, err = Slice(&students).FirstUnsortedIndex()
+	if err != nil || index != -1 {
+		t.Fatal("The first unsorted index of sorted slice should be -1! index: ", index, " error: ", err)
+	}
+
+	if _, err = Slice(values).FirstUnsortedIndex(); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
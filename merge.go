@@ -0,0 +1,123 @@
+package generic
+
+import (
+	"container/heap"
+	"reflect"
+)
+
+// The options to merge sorted slices
+type MergeOptions struct {
+	// drop the elements which are equal to the previous merged element
+	Unique bool
+	// the compare function name of struct, default is "Compare"
+	CompareFuncName string
+}
+
+// Merge the slice and the other slice, which are both sorted in ascending order, into dst.
+// The other slice and dst should be pointers of the same slice type, dst can be the slice itself or the other slice.
+// The equal elements of the slice are merged before those of the other slice.
+// It supports the same types as QuickSort.
+func (s *slice) MergeSorted(otherSlicePtr, dstSlicePtr interface{}) error {
+	return s.MergeSortedWith(MergeOptions{}, dstSlicePtr, otherSlicePtr)
+}
+
+// Merge the slice and the other slices, which are all sorted in ascending order, into dst with the options.
+// The other slices and dst should be pointers of the same slice type, dst can be any one of the merged slices.
+// The equal elements are merged in the order of the slices, the slice itself is the first one.
+// It supports the same types as QuickSort.
+func (s *slice) MergeSortedWith(opts MergeOptions, dstSlicePtr interface{}, otherSlicePtrs ...interface{}) error {
+	compareFuncName := opts.CompareFuncName
+	if compareFuncName == "" {
+		compareFuncName = defaultCompareFuncName
+	}
+	sliceValue, compareFunc, err := s.prepareSort(compareFuncName)
+	if err != nil {
+		return err
+	}
+	dstValue, err := checkSameSlice(dstSlicePtr, sliceValue.Type(), "dst")
+	if err != nil {
+		return err
+	}
+
+	sources := []reflect.Value{sliceValue}
+	length := sliceValue.Len()
+	for _, otherSlicePtr := range otherSlicePtrs {
+		otherValue, err := checkSameSlice(otherSlicePtr, sliceValue.Type(), "other")
+		if err != nil {
+			return err
+		}
+		sources = append(sources, otherValue)
+		length += otherValue.Len()
+	}
+
+	// the result is a new slice, so dst can be one of the sources
+	merged := reflect.MakeSlice(sliceValue.Type(), 0, length)
+	appendElem := func(elem reflect.Value) {
+		if opts.Unique && merged.Len() > 0 && compareFunc(merged.Index(merged.Len()-1), elem) == 0 {
+			return
+		}
+		merged = reflect.Append(merged, elem)
+	}
+
+	cursors := &mergeCursors{sources: sources, compareFunc: compareFunc}
+	for source := range sources {
+		if sources[source].Len() > 0 {
+			cursors.items = append(cursors.items, mergeCursor{source, 0})
+		}
+	}
+	heap.Init(cursors)
+	for cursors.Len() > 0 {
+		cursor := &cursors.items[0]
+		appendElem(sources[cursor.source].Index(cursor.index))
+		cursor.index++
+		if cursor.index < sources[cursor.source].Len() {
+			heap.Fix(cursors, 0)
+		} else {
+			heap.Pop(cursors)
+		}
+	}
+
+	dstValue.Set(merged)
+	return nil
+}
+
+// the position of the next element to merge in the source slice
+type mergeCursor struct {
+	source int
+	index  int
+}
+
+// the min heap of cursors for k-way merging, it implements heap.Interface.
+type mergeCursors struct {
+	sources     []reflect.Value
+	compareFunc compareFunc
+	items       []mergeCursor
+}
+
+func (c *mergeCursors) Len() int {
+	return len(c.items)
+}
+
+func (c *mergeCursors) Less(i, j int) bool {
+	cursor1, cursor2 := c.items[i], c.items[j]
+	result := c.compareFunc(c.sources[cursor1.source].Index(cursor1.index), c.sources[cursor2.source].Index(cursor2.index))
+	if result != 0 {
+		return result < 0
+	}
+	// the equal elements are merged in the order of sources
+	return cursor1.source < cursor2.source
+}
+
+func (c *mergeCursors) Swap(i, j int) {
+	c.items[i], c.items[j] = c.items[j], c.items[i]
+}
+
+func (c *mergeCursors) Push(item interface{}) {
+	c.items = append(c.items, item.(mergeCursor))
+}
+
+func (c *mergeCursors) Pop() interface{} {
+	item := c.items[len(c.items)-1]
+	c.items = c.items[:len(c.items)-1]
+	return item
+}
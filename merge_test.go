@@ -0,0 +1,82 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceMergeSorted(t *testing.T) {
+	values1 := []int{1, 3, 5, 7}
+	values2 := []int{2, 3, 6}
+	merged := []int{}
+	if err := Slice(&values1).MergeSorted(&values2, &merged); err != nil {
+		t.Fatal("Failed to merge sorted slices! error: ", err)
+	}
+	expected := []int{1, 2, 3, 3, 5, 6, 7}
+	if len(merged) != len(expected) {
+		t.Fatal("After merge sorted slices, the length should be right! actual: ", merged)
+	}
+	for i := range expected {
+		if merged[i] != expected[i] {
+			t.Fatal("After merge sorted slices, the elements should be ordered! actual: ", merged)
+		}
+	}
+
+	// dst is the slice itself
+	if err := Slice(&values1).MergeSorted(&values2, &values1); err != nil || len(values1) != 7 || values1[6] != 7 {
+		t.Fatal("Failed to merge sorted slices into the slice itself! actual: ", values1, " error: ", err)
+	}
+
+	empty := []int{}
+	if err := Slice(&empty).MergeSorted(&values2, &merged); err != nil || len(merged) != 3 {
+		t.Fatal("Failed to merge empty slice! actual: ", merged, " error: ", err)
+	}
+
+	int64s := []int64{}
+	if err := Slice(&values1).MergeSorted(&int64s, &merged); err == nil {
+		t.Fatal("It should be error when the other slice type is different!")
+	}
+	if err := Slice(&values1).MergeSorted(&values2, merged); err == nil {
+		t.Fatal("It should be error when dst is not slice pointer!")
+	}
+}
+
+func TestSliceMergeSorted_Stable(t *testing.T) {
+	students1 := []student{{name: "a1", age: 11}, {name: "a2", age: 14}}
+	students2 := []student{{name: "b1", age: 11}, {name: "b2", age: 12}}
+	merged := []student{}
+	if err := Slice(&students1).MergeSorted(&students2, &merged); err != nil {
+		t.Fatal("Failed to merge sorted struct slices! error: ", err)
+	}
+	if merged[0].name != "a1" || merged[1].name != "b1" || merged[2].name != "b2" || merged[3].name != "a2" {
+		t.Fatal("After merge sorted struct slices, the equal elements of the slice should be first! actual: ", merged)
+	}
+}
+
+func TestSliceMergeSortedWith(t *testing.T) {
+	values1 := []string{"a", "c", "e"}
+	values2 := []string{"b", "c", "d"}
+	values3 := []string{"a", "f"}
+	values4 := []string{}
+	merged := []string{}
+	err := Slice(&values1).MergeSortedWith(MergeOptions{Unique: true}, &merged, &values2, &values3, &values4)
+	if err != nil {
+		t.Fatal("Failed to k-way merge sorted slices! error: ", err)
+	}
+	expected := []string{"a", "b", "c", "d", "e", "f"}
+	if len(merged) != len(expected) {
+		t.Fatal("After k-way merge sorted slices with unique, the equal elements should be dropped! actual: ", merged)
+	}
+	for i := range expected {
+		if merged[i] != expected[i] {
+			t.Fatal("After k-way merge sorted slices, the elements should be ordered! actual: ", merged)
+		}
+	}
+
+	students1 := []student{{name: "1", age: 11}}
+	students2 := []student{{name: "2", age: 11}, {name: "3", age: 13}}
+	mergedStudents := []student{}
+	err = Slice(&students1).MergeSortedWith(MergeOptions{Unique: true, CompareFuncName: "CompareByAge"}, &mergedStudents, &students2)
+	if err != nil || len(mergedStudents) != 2 || mergedStudents[0].name != "1" || mergedStudents[1].name != "3" {
+		t.Fatal("Failed to merge sorted struct slices by CompareByAge with unique! actual: ", mergedStudents, " error: ", err)
+	}
+}
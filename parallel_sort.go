@@ -0,0 +1,94 @@
+package generic
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// the slice shorter than it is sorted in the current goroutine by ParallelSort
+const parallelSortThreshold = 8192
+
+// the minimum count of elements sorted by each worker of ParallelSort
+const parallelSortMinPartSize = parallelSortThreshold / 2
+
+// sort slice by quick sort algorithm in workers goroutines, then merge the sorted parts.
+// If workers <= 0, the number of workers is runtime.GOMAXPROCS(0).
+// The number of workers is no more than runtime.GOMAXPROCS(0), and each worker sorts at least 4096 elements,
+// so the slice shorter than 8192 elements is sorted by QuickSort directly.
+// It supports the same types as QuickSort, and the compare function of struct should be safe for concurrent use.
+func (s *slice) ParallelSort(workers int) error {
+	sliceValue, compareFunc, err := s.prepareSort(defaultCompareFuncName)
+	if err != nil {
+		return err
+	}
+
+	length := sliceValue.Len()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, runtime.GOMAXPROCS(0), length/parallelSortMinPartSize)
+	if workers <= 1 || length < parallelSortThreshold {
+		quickSort(sliceValue, 0, length-1, compareFunc)
+		return nil
+	}
+
+	// sort each part in its own goroutine
+	bounds := []int{}
+	for worker := 0; worker <= workers; worker++ {
+		bounds = append(bounds, length*worker/workers)
+	}
+	var waitGroup sync.WaitGroup
+	for part := 0; part < workers; part++ {
+		waitGroup.Add(1)
+		go func(lowIndex, highIndex int) {
+			defer waitGroup.Done()
+			quickSort(sliceValue, lowIndex, highIndex-1, compareFunc)
+		}(bounds[part], bounds[part+1])
+	}
+	waitGroup.Wait()
+
+	// merge the adjacent sorted parts until there is only one part
+	src, dst := sliceValue, reflect.MakeSlice(sliceValue.Type(), length, length)
+	for len(bounds) > 2 {
+		mergedBounds := []int{}
+		for part := 0; part+1 < len(bounds); part += 2 {
+			mergedBounds = append(mergedBounds, bounds[part])
+			if part+2 >= len(bounds) {
+				// the last part without pair
+				reflect.Copy(dst.Slice(bounds[part], bounds[part+1]), src.Slice(bounds[part], bounds[part+1]))
+				continue
+			}
+
+			waitGroup.Add(1)
+			go func(lowIndex, middleIndex, highIndex int) {
+				defer waitGroup.Done()
+				mergeRuns(src, dst, lowIndex, middleIndex, highIndex, compareFunc)
+			}(bounds[part], bounds[part+1], bounds[part+2])
+		}
+		mergedBounds = append(mergedBounds, length)
+		waitGroup.Wait()
+
+		bounds = mergedBounds
+		src, dst = dst, src
+	}
+
+	if src.Pointer() != sliceValue.Pointer() {
+		reflect.Copy(sliceValue, src)
+	}
+	return nil
+}
+
+// merge the sorted elements in [lowIndex, middleIndex) and [middleIndex, highIndex) of src into dst
+func mergeRuns(src, dst reflect.Value, lowIndex, middleIndex, highIndex int, compareFunc compareFunc) {
+	leftIndex, rightIndex := lowIndex, middleIndex
+	for index := lowIndex; index < highIndex; index++ {
+		if rightIndex >= highIndex || (leftIndex < middleIndex && compareFunc(src.Index(leftIndex), src.Index(rightIndex)) <= 0) {
+			dst.Index(index).Set(src.Index(leftIndex))
+			leftIndex++
+		} else {
+			dst.Index(index).Set(src.Index(rightIndex))
+			rightIndex++
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package generic
+
+import (
+	"math/rand"
+	"runtime"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func randomStudents(count int, seed int64) []student {
+	random := rand.New(rand.NewSource(seed))
+	students := []student{}
+	for i := 0; i < count; i++ {
+		students = append(students, student{name: strconv.Itoa(i), age: random.Intn(count)})
+	}
+	return students
+}
+
+func TestSliceParallelSort(t *testing.T) {
+	// the workers are limited by GOMAXPROCS, make sure the parts are merged
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	for _, workers := range []int{0, 1, 3, 4, 7} {
+		students := randomStudents(20000, int64(workers))
+		if err := Slice(&students).ParallelSort(workers); err != nil {
+			t.Fatal("Failed to parallel sort struct slice! error: ", err)
+		}
+		for i := 0; i < len(students)-1; i++ {
+			if students[i].age > students[i+1].age {
+				t.Fatal("After parallel sort with ", workers, " workers, the elements should be ordered!")
+			}
+		}
+	}
+
+	values := rand.New(rand.NewSource(1)).Perm(100000)
+	expected := append([]int{}, values...)
+	sort.Ints(expected)
+	if err := Slice(&values).ParallelSort(4); err != nil {
+		t.Fatal("Failed to parallel sort int slice! error: ", err)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatal("After parallel sort int slice, the elements should be same as sort package!")
+		}
+	}
+
+	values = rand.New(rand.NewSource(2)).Perm(10000)
+	if err := Slice(&values).ParallelSort(1 << 22); err != nil {
+		t.Fatal("Failed to parallel sort with huge workers! error: ", err)
+	}
+	for i := range values {
+		if values[i] != i {
+			t.Fatal("After parallel sort with huge workers, the elements should be ordered!")
+		}
+	}
+
+	small := []int{3, 1, 2}
+	if err := Slice(&small).ParallelSort(4); err != nil || small[0] != 1 || small[1] != 2 || small[2] != 3 {
+		t.Fatal("Failed to parallel sort small slice! error: ", err)
+	}
+
+	if err := Slice(small).ParallelSort(4); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func BenchmarkSliceQuickSort_Structs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		students := randomStudents(100000, int64(i))
+		b.StartTimer()
+		Slice(&students).QuickSort()
+	}
+}
+
+func BenchmarkSliceParallelSort_Structs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		students := randomStudents(100000, int64(i))
+		b.StartTimer()
+		Slice(&students).ParallelSort(0)
+	}
+}
@@ -0,0 +1,121 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+)
+
+// sort the k smallest elements of slice in ascending order at the beginning of slice,
+// the order of the other elements is undefined.
+// It supports the same types as QuickSort. If k is greater than the length of slice, all elements are sorted.
+func (s *slice) PartialSort(k int) error {
+	sliceValue, compareFunc, err := s.prepareSort(defaultCompareFuncName)
+	if err != nil {
+		return err
+	}
+	if k < 0 {
+		return errors.New("k should not be negative!")
+	}
+	if k > sliceValue.Len() {
+		k = sliceValue.Len()
+	}
+	if k == 0 {
+		return nil
+	}
+
+	quickSelect(sliceValue, 0, sliceValue.Len()-1, k-1, compareFunc)
+	quickSort(sliceValue, 0, k-1, compareFunc)
+	return nil
+}
+
+// rearrange the elements so that the element at index n is the one which would be there if the slice were sorted,
+// the elements before n are not greater than it, and the elements after n are not less than it.
+// It supports the same types as QuickSort.
+func (s *slice) NthElement(n int) error {
+	sliceValue, compareFunc, err := s.prepareSort(defaultCompareFuncName)
+	if err != nil {
+		return err
+	}
+	if n < 0 || n >= sliceValue.Len() {
+		return errors.New("index out of range!")
+	}
+
+	quickSelect(sliceValue, 0, sliceValue.Len()-1, n, compareFunc)
+	return nil
+}
+
+// fill dst with the k largest elements of slice in descending order, the slice is not changed.
+// dst should be pointer of the same slice type. If k is greater than the length of slice, all elements are filled.
+// It supports the same types as QuickSort.
+func (s *slice) TopK(k int, dst interface{}) error {
+	sliceValue, compareFunc, err := s.prepareSort(defaultCompareFuncName)
+	if err != nil {
+		return err
+	}
+	dstValue, err := checkSameSlice(dst, sliceValue.Type(), "dst")
+	if err != nil {
+		return err
+	}
+	if k < 0 {
+		return errors.New("k should not be negative!")
+	}
+	if k > sliceValue.Len() {
+		k = sliceValue.Len()
+	}
+	if k == 0 {
+		dstValue.Set(reflect.MakeSlice(sliceValue.Type(), 0, 0))
+		return nil
+	}
+
+	// the top of heap is the smallest one of the k largest elements
+	heapCompareFunc := compareReverse(compareFunc)
+	heap := reflect.MakeSlice(sliceValue.Type(), k, k)
+	reflect.Copy(heap, sliceValue.Slice(0, k))
+	for root := k/2 - 1; root >= 0; root-- {
+		siftDown(heap, root, k, heapCompareFunc)
+	}
+	for index := k; index < sliceValue.Len(); index++ {
+		if compareFunc(sliceValue.Index(index), heap.Index(0)) > 0 {
+			heap.Index(0).Set(sliceValue.Index(index))
+			siftDown(heap, 0, k, heapCompareFunc)
+		}
+	}
+
+	// heap sort in reverse order, then the largest is the first
+	for size := k - 1; size > 0; size-- {
+		swap(heap, 0, size)
+		siftDown(heap, 0, size, heapCompareFunc)
+	}
+
+	dstValue.Set(heap)
+	return nil
+}
+
+// check the parameter is pointer of slice whose type is sliceType, and return the slice value.
+// name is the parameter name used in error message.
+func checkSameSlice(slicePtr interface{}, sliceType reflect.Type, name string) (reflect.Value, error) {
+	if err := Slice(slicePtr).checkSlice(); err != nil {
+		return reflect.Value{}, errors.New(name + " " + err.Error())
+	}
+
+	sliceValue := reflect.ValueOf(slicePtr).Elem()
+	if sliceValue.Type() != sliceType {
+		return reflect.Value{}, errors.New(name + " type mismatch: " + sliceValue.Type().String() + " is not " + sliceType.String())
+	}
+	return sliceValue, nil
+}
+
+// the internal function for implementing quick select algorithm.
+// rearrange the elements in [lowIndex, highIndex] so that the element at nthIndex is in its sorted position.
+func quickSelect(slice reflect.Value, lowIndex, highIndex, nthIndex int, compareFunc compareFunc) {
+	for lowIndex < highIndex {
+		lessIndex, greaterIndex := partition3(slice, lowIndex, highIndex, compareFunc)
+		if nthIndex < lessIndex {
+			highIndex = lessIndex - 1
+		} else if nthIndex > greaterIndex {
+			lowIndex = greaterIndex + 1
+		} else {
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package generic
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSlicePartialSort(t *testing.T) {
+	values := rand.New(rand.NewSource(1)).Perm(1000)
+	if err := Slice(&values).PartialSort(10); err != nil {
+		t.Fatal("Failed to partial sort int slice! error: ", err)
+	}
+	for i := 0; i < 10; i++ {
+		if values[i] != i {
+			t.Fatal("After partial sort, the k smallest elements should be ordered at the beginning! actual: ", values[:10])
+		}
+	}
+
+	students := []student{{name: "1", age: 15}, {name: "2", age: 11}, {name: "3", age: 22}}
+	if err := Slice(&students).PartialSort(5); err != nil {
+		t.Fatal("Failed to partial sort struct slice when k is greater than length! error: ", err)
+	}
+	if students[0].age != 11 || students[1].age != 15 || students[2].age != 22 {
+		t.Fatal("After partial sort with large k, all elements should be ordered! actual: ", students)
+	}
+
+	if err := Slice(&students).PartialSort(-1); err == nil {
+		t.Fatal("It should be error when k is negative!")
+	}
+}
+
+func TestSliceNthElement(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+	for n := 0; n < 50; n++ {
+		values := []int{}
+		for i := 0; i < 50; i++ {
+			values = append(values, random.Intn(10))
+		}
+		expected := append([]int{}, values...)
+		sort.Ints(expected)
+
+		if err := Slice(&values).NthElement(n); err != nil {
+			t.Fatal("Failed to get nth element! error: ", err)
+		}
+		if values[n] != expected[n] {
+			t.Fatal("After nth element, the element at n should be in sorted position! actual: ", values)
+		}
+		for i := 0; i < len(values); i++ {
+			if (i < n && values[i] > values[n]) || (i > n && values[i] < values[n]) {
+				t.Fatal("After nth element, the elements should be partitioned! actual: ", values)
+			}
+		}
+	}
+
+	values := []int{1, 2}
+	if err := Slice(&values).NthElement(2); err == nil {
+		t.Fatal("It should be error when n is out of range!")
+	}
+}
+
+func TestSliceTopK(t *testing.T) {
+	values := rand.New(rand.NewSource(3)).Perm(1000)
+	top := []int{}
+	if err := Slice(&values).TopK(5, &top); err != nil {
+		t.Fatal("Failed to get top k elements! error: ", err)
+	}
+	if len(top) != 5 || top[0] != 999 || top[1] != 998 || top[2] != 997 || top[3] != 996 || top[4] != 995 {
+		t.Fatal("Top k should be the k largest elements in descending order! actual: ", top)
+	}
+	if len(values) != 1000 {
+		t.Fatal("Top k should not change the slice!")
+	}
+
+	small := []int{3, 1, 2}
+	if err := Slice(&small).TopK(0, &top); err != nil || len(top) != 0 {
+		t.Fatal("Top k with k 0 should be empty! actual: ", top, " error: ", err)
+	}
+
+	students := []*student{{name: "1", age: 15}, nil, {name: "2", age: 22}}
+	topStudents := []*student{}
+	if err := Slice(&students).TopK(10, &topStudents); err != nil {
+		t.Fatal("Failed to get top k struct pointers! error: ", err)
+	}
+	if len(topStudents) != 3 || topStudents[0].age != 22 || topStudents[1].age != 15 || topStudents[2] != nil {
+		t.Fatal("Top k with large k should contain all elements in descending order! actual: ", topStudents)
+	}
+
+	wrongDst := []int64{}
+	if err := Slice(&values).TopK(5, &wrongDst); err == nil {
+		t.Fatal("It should be error when dst type is different from slice!")
+	}
+	if err := Slice(&values).TopK(5, top); err == nil {
+		t.Fatal("It should be error when dst is not slice pointer!")
+	}
+}
@@ -0,0 +1,142 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// sort slice by LSD radix sort algorithm in ascending order.
+// It supports slice of int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint, float32 and float64,
+// and the named types of them such as "type ID uint32", except that they have compare function.
+// The elements are sorted on the typed slice without reflection, it is much faster than QuickSort.
+// For float types, NaN is less than any other number, the same as QuickSort.
+func (s *slice) RadixSort() error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	if !radixSortTyped(s.slicePtr) {
+		return errors.New("unsupport type: " + reflect.TypeOf(s.slicePtr).Elem().String())
+	}
+	return nil
+}
+
+// sort the slice by radix sort if the kind of element is fixed-width number, return false if not supported.
+// The named number types are sorted on their underlying types, except that they have compare function,
+// which takes precedence over the kind of type.
+func radixSortTyped(slicePtr interface{}) bool {
+	sliceValue := reflect.ValueOf(slicePtr).Elem()
+	elemType := sliceValue.Type().Elem()
+	if hasMethod(elemType, defaultCompareFuncName) || hasMethod(elemType, lessFuncName) {
+		return false
+	}
+
+	// reinterpret the backing array as the slice of underlying type
+	length := sliceValue.Len()
+	data := sliceValue.UnsafePointer()
+	switch elemType.Kind() {
+	case reflect.Int8:
+		radixSort(unsafe.Slice((*int8)(data), length), 1, func(value int8) uint64 { return uint64(uint8(value) ^ 0x80) })
+	case reflect.Int16:
+		radixSort(unsafe.Slice((*int16)(data), length), 2, func(value int16) uint64 { return uint64(uint16(value) ^ 0x8000) })
+	case reflect.Int32:
+		radixSort(unsafe.Slice((*int32)(data), length), 4, func(value int32) uint64 { return uint64(uint32(value) ^ 0x80000000) })
+	case reflect.Int64:
+		radixSort(unsafe.Slice((*int64)(data), length), 8, func(value int64) uint64 { return uint64(value) ^ (1 << 63) })
+	case reflect.Int:
+		radixSort(unsafe.Slice((*int)(data), length), 8, func(value int) uint64 { return uint64(value) ^ (1 << 63) })
+	case reflect.Uint8:
+		radixSort(unsafe.Slice((*uint8)(data), length), 1, func(value uint8) uint64 { return uint64(value) })
+	case reflect.Uint16:
+		radixSort(unsafe.Slice((*uint16)(data), length), 2, func(value uint16) uint64 { return uint64(value) })
+	case reflect.Uint32:
+		radixSort(unsafe.Slice((*uint32)(data), length), 4, func(value uint32) uint64 { return uint64(value) })
+	case reflect.Uint64:
+		radixSort(unsafe.Slice((*uint64)(data), length), 8, func(value uint64) uint64 { return value })
+	case reflect.Uint:
+		radixSort(unsafe.Slice((*uint)(data), length), 8, func(value uint) uint64 { return uint64(value) })
+	case reflect.Float32:
+		radixSort(unsafe.Slice((*float32)(data), length), 4, float32Key)
+	case reflect.Float64:
+		radixSort(unsafe.Slice((*float64)(data), length), 8, float64Key)
+	default:
+		return false
+	}
+	return true
+}
+
+// convert float32 to the key whose unsigned order is the same as the order of compare function
+func float32Key(value float32) uint64 {
+	if math.IsNaN(float64(value)) {
+		return 0
+	}
+
+	bits := math.Float32bits(value)
+	if bits&(1<<31) != 0 {
+		return uint64(^bits)
+	}
+	return uint64(bits | (1 << 31))
+}
+
+// convert float64 to the key whose unsigned order is the same as the order of compare function
+func float64Key(value float64) uint64 {
+	if math.IsNaN(value) {
+		return 0
+	}
+
+	bits := math.Float64bits(value)
+	if bits&(1<<63) != 0 {
+		return ^bits
+	}
+	return bits | (1 << 63)
+}
+
+// the internal function for implementing LSD radix sort algorithm,
+// sort values by the lowest width bytes of their keys.
+func radixSort[T any](values []T, width int, key func(T) uint64) {
+	length := len(values)
+	if length <= 1 {
+		return
+	}
+
+	keys := make([]uint64, length)
+	for index, value := range values {
+		keys[index] = key(value)
+	}
+
+	original := values
+	bufferValues := make([]T, length)
+	bufferKeys := make([]uint64, length)
+	for shift := 0; shift < width*8; shift += 8 {
+		var offsets [256]int
+		for _, key := range keys {
+			offsets[byte(key>>shift)]++
+		}
+		// all keys have the same byte, nothing to do
+		if offsets[byte(keys[0]>>shift)] == length {
+			continue
+		}
+
+		offset := 0
+		for digit, count := range offsets {
+			offsets[digit] = offset
+			offset += count
+		}
+		for index, key := range keys {
+			digit := byte(key >> shift)
+			bufferKeys[offsets[digit]] = key
+			bufferValues[offsets[digit]] = values[index]
+			offsets[digit]++
+		}
+
+		keys, bufferKeys = bufferKeys, keys
+		values, bufferValues = bufferValues, values
+	}
+
+	if &values[0] != &original[0] {
+		copy(original, values)
+	}
+}
@@ -0,0 +1,136 @@
+package generic
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"testing/quick"
+)
+
+type userID uint32
+
+type celsius float64
+
+func TestSliceRadixSort_Property(t *testing.T) {
+	int8Property := func(values []int8) bool {
+		expected := append([]int8{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+	int32Property := func(values []int32) bool {
+		expected := append([]int32{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+	int64Property := func(values []int64) bool {
+		expected := append([]int64{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+	uint16Property := func(values []uint16) bool {
+		expected := append([]uint16{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+	uint64Property := func(values []uint64) bool {
+		expected := append([]uint64{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+	float32Property := func(values []float32) bool {
+		expected := append([]float32{}, values...)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		return Slice(&values).RadixSort() == nil && equalSlices(values, expected)
+	}
+
+	for _, property := range []interface{}{int8Property, int32Property, int64Property, uint16Property, uint64Property, float32Property} {
+		if err := quick.Check(property, nil); err != nil {
+			t.Fatal("Radix sort should be same as sort package! error: ", err)
+		}
+	}
+}
+
+func TestSliceRadixSort_Float64(t *testing.T) {
+	nan := math.NaN()
+	values := []float64{3.5, nan, math.Inf(1), -1, math.Copysign(0, -1), 0, math.Inf(-1), -math.MaxFloat64, math.SmallestNonzeroFloat64}
+	if err := Slice(&values).RadixSort(); err != nil {
+		t.Fatal("Failed to radix sort float64 slice! error: ", err)
+	}
+	if !math.IsNaN(values[0]) {
+		t.Fatal("After radix sort float64 slice, NaN should be first! actual: ", values)
+	}
+	for i := 1; i < len(values)-1; i++ {
+		if values[i] > values[i+1] {
+			t.Fatal("After radix sort float64 slice, the elements should be ordered! actual: ", values)
+		}
+	}
+}
+
+func TestSliceRadixSort_NamedType(t *testing.T) {
+	ids := []userID{3, 1, 2}
+	if err := Slice(&ids).RadixSort(); err != nil || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Fatal("Failed to radix sort named type slice! actual: ", ids, " error: ", err)
+	}
+
+	temperatures := []celsius{-1.5, 3, -20}
+	if err := Slice(&temperatures).RadixSort(); err != nil || temperatures[0] != -20 || temperatures[1] != -1.5 || temperatures[2] != 3 {
+		t.Fatal("Failed to radix sort named float type slice! actual: ", temperatures, " error: ", err)
+	}
+
+	// the compare function takes precedence over the kind of type
+	priorities := []priority{1, 3, 2}
+	if err := Slice(&priorities).RadixSort(); err == nil {
+		t.Fatal("It should be error when radix sort named type slice with compare function!")
+	}
+	if err := Slice(&priorities).QuickSort(); err != nil || priorities[0] != 3 || priorities[1] != 2 || priorities[2] != 1 {
+		t.Fatal("Quick sort should fall back to compare function when radix sort is unsupported! actual: ", priorities, " error: ", err)
+	}
+}
+
+func TestSliceRadixSort_Unsupported(t *testing.T) {
+
+	names := []string{"b", "a"}
+	if err := Slice(&names).RadixSort(); err == nil {
+		t.Fatal("It should be error when radix sort string slice!")
+	}
+}
+
+func equalSlices[T comparable](values, expected []T) bool {
+	if len(values) != len(expected) {
+		return false
+	}
+	for i := range values {
+		if values[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkSliceRadixSort_Uint32s(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		values := make([]uint32, 100000)
+		for index := range values {
+			values[index] = random.Uint32()
+		}
+		b.StartTimer()
+		Slice(&values).RadixSort()
+	}
+}
+
+func BenchmarkSliceQuickSortBy_Uint32s(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		values := make([]uint32, 100000)
+		for index := range values {
+			values[index] = random.Uint32()
+		}
+		b.StartTimer()
+		// QuickSortBy doesn't use radix sort
+		Slice(&values).QuickSortBy(defaultCompareFuncName)
+	}
+}
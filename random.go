@@ -0,0 +1,117 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+)
+
+// Shuffle the elements of slice randomly by Fisher-Yates algorithm.
+// The same seed always produces the same permutation for the same length of slice.
+// The slice can be any type slice, include struct slice.
+func (s *slice) Shuffle(seed int64) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	random := rand.New(rand.NewSource(seed))
+	for index := sliceValue.Len() - 1; index > 0; index-- {
+		swap(sliceValue, index, random.Intn(index+1))
+	}
+	return nil
+}
+
+// Fill dst with n elements sampled randomly from slice without replacement, the slice is not changed.
+// dst should be pointer of the same slice type. The same seed always produces the same sample.
+func (s *slice) Sample(n int, seed int64, dst interface{}) error {
+	sliceValue, dstValue, err := s.prepareSample(n, dst)
+	if err != nil {
+		return err
+	}
+
+	// partial Fisher-Yates shuffle on indexes
+	random := rand.New(rand.NewSource(seed))
+	indexes := make([]int, sliceValue.Len())
+	for index := range indexes {
+		indexes[index] = index
+	}
+	for index := 0; index < n; index++ {
+		other := index + random.Intn(len(indexes)-index)
+		indexes[index], indexes[other] = indexes[other], indexes[index]
+	}
+
+	dstValue.Set(pick(sliceValue, indexes[:n]))
+	return nil
+}
+
+// Fill dst with n elements sampled randomly from slice without replacement,
+// the probability of element to be sampled is proportional to its weight. The slice is not changed.
+// The weight should not be negative, and the element whose weight is 0 is never sampled.
+// dst should be pointer of the same slice type. The same seed always produces the same sample.
+func (s *slice) WeightedSample(n int, seed int64, weight func(interface{}) float64, dst interface{}) error {
+	if weight == nil {
+		return errors.New("weight function is nil!")
+	}
+	sliceValue, dstValue, err := s.prepareSample(n, dst)
+	if err != nil {
+		return err
+	}
+
+	// Efraimidis-Spirakis algorithm, sample the n elements with the largest keys log(u)/weight
+	random := rand.New(rand.NewSource(seed))
+	indexes := []int{}
+	keys := make([]float64, sliceValue.Len())
+	for index := 0; index < sliceValue.Len(); index++ {
+		elemWeight := weight(sliceValue.Index(index).Interface())
+		if elemWeight < 0 || math.IsNaN(elemWeight) || math.IsInf(elemWeight, 0) {
+			return errors.New("weight should be non-negative finite number!")
+		}
+		// always draw a random number, so the sample doesn't depend on the weights of other elements
+		u := random.Float64()
+		if elemWeight == 0 {
+			continue
+		}
+		indexes = append(indexes, index)
+		keys[index] = math.Log(u) / elemWeight
+	}
+	if n > len(indexes) {
+		return errors.New("n is greater than the count of elements with positive weight!")
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return keys[indexes[i]] > keys[indexes[j]]
+	})
+	dstValue.Set(pick(sliceValue, indexes[:n]))
+	return nil
+}
+
+// check the slice, n and dst for sampling, then return the slice value and the dst value.
+func (s *slice) prepareSample(n int, dst interface{}) (reflect.Value, reflect.Value, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	dstValue, err := checkSameSlice(dst, sliceValue.Type(), "dst")
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+	if n < 0 || n > sliceValue.Len() {
+		return reflect.Value{}, reflect.Value{}, errors.New("n out of range!")
+	}
+	return sliceValue, dstValue, nil
+}
+
+// return a new slice contains the elements at indexes of slice
+func pick(sliceValue reflect.Value, indexes []int) reflect.Value {
+	picked := reflect.MakeSlice(sliceValue.Type(), len(indexes), len(indexes))
+	for index, sliceIndex := range indexes {
+		picked.Index(index).Set(sliceValue.Index(sliceIndex))
+	}
+	return picked
+}
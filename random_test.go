@@ -0,0 +1,133 @@
+package generic
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSliceShuffle(t *testing.T) {
+	values1 := []int{}
+	values2 := []int{}
+	for i := 0; i < 100; i++ {
+		values1 = append(values1, i)
+		values2 = append(values2, i)
+	}
+
+	if err := Slice(&values1).Shuffle(42); err != nil {
+		t.Fatal("Failed to shuffle slice! error: ", err)
+	}
+	if err := Slice(&values2).Shuffle(42); err != nil {
+		t.Fatal("Failed to shuffle slice! error: ", err)
+	}
+	moved := 0
+	for i := range values1 {
+		if values1[i] != values2[i] {
+			t.Fatal("Shuffle with the same seed should produce the same permutation!")
+		}
+		if values1[i] != i {
+			moved++
+		}
+	}
+	if moved == 0 {
+		t.Fatal("Shuffle should change the order of elements!")
+	}
+
+	sort.Ints(values1)
+	for i := range values1 {
+		if values1[i] != i {
+			t.Fatal("Shuffle should keep the same elements! actual: ", values1)
+		}
+	}
+
+	if err := Slice(values1).Shuffle(42); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceSample(t *testing.T) {
+	students := []student{}
+	for i := 0; i < 20; i++ {
+		students = append(students, student{age: i})
+	}
+
+	sample1 := []student{}
+	sample2 := []student{}
+	if err := Slice(&students).Sample(5, 7, &sample1); err != nil {
+		t.Fatal("Failed to sample slice! error: ", err)
+	}
+	if err := Slice(&students).Sample(5, 7, &sample2); err != nil {
+		t.Fatal("Failed to sample slice! error: ", err)
+	}
+	if len(sample1) != 5 {
+		t.Fatal("Sample should contain n elements! actual: ", sample1)
+	}
+	seen := map[int]bool{}
+	for i := range sample1 {
+		if sample1[i] != sample2[i] {
+			t.Fatal("Sample with the same seed should produce the same sample!")
+		}
+		if seen[sample1[i].age] {
+			t.Fatal("Sample should not contain duplicated elements! actual: ", sample1)
+		}
+		seen[sample1[i].age] = true
+	}
+	for i := 0; i < 20; i++ {
+		if students[i].age != i {
+			t.Fatal("Sample should not change the slice!")
+		}
+	}
+
+	if err := Slice(&students).Sample(21, 7, &sample1); err == nil {
+		t.Fatal("It should be error when n is greater than length of slice!")
+	}
+	wrongDst := []int{}
+	if err := Slice(&students).Sample(1, 7, &wrongDst); err == nil {
+		t.Fatal("It should be error when dst type is different from slice!")
+	}
+}
+
+func TestSliceWeightedSample(t *testing.T) {
+	values := []string{"heavy", "light", "never"}
+	weight := func(value interface{}) float64 {
+		switch value.(string) {
+		case "heavy":
+			return 9
+		case "light":
+			return 1
+		}
+		return 0
+	}
+
+	heavyCount := 0
+	for seed := int64(0); seed < 1000; seed++ {
+		sample := []string{}
+		if err := Slice(&values).WeightedSample(1, seed, weight, &sample); err != nil {
+			t.Fatal("Failed to weighted sample slice! error: ", err)
+		}
+		if sample[0] == "never" {
+			t.Fatal("Weighted sample should never contain the element whose weight is 0!")
+		}
+		if sample[0] == "heavy" {
+			heavyCount++
+		}
+	}
+	if heavyCount < 850 || heavyCount > 950 {
+		t.Fatal("Weighted sample should be proportional to weight! heavy count: ", heavyCount)
+	}
+
+	sample1 := []string{}
+	sample2 := []string{}
+	Slice(&values).WeightedSample(2, 3, weight, &sample1)
+	Slice(&values).WeightedSample(2, 3, weight, &sample2)
+	if len(sample1) != 2 || sample1[0] != sample2[0] || sample1[1] != sample2[1] {
+		t.Fatal("Weighted sample with the same seed should produce the same sample!")
+	}
+
+	if err := Slice(&values).WeightedSample(3, 3, weight, &sample1); err == nil {
+		t.Fatal("It should be error when n is greater than the count of elements with positive weight!")
+	}
+	negative := func(value interface{}) float64 { return -1 }
+	if err := Slice(&values).WeightedSample(1, 3, negative, &sample1); err == nil {
+		t.Fatal("It should be error when the weight is negative!")
+	}
+}
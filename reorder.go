@@ -0,0 +1,100 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Reverse the order of elements in slice.
+// The slice can be any type slice, include struct slice.
+func (s *slice) Reverse() error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	reverse(sliceValue, 0, sliceValue.Len()-1)
+	return nil
+}
+
+// Rotate the elements of slice to the right by k positions, the last k elements become the first ones.
+// If k is negative, rotate the elements to the left by -k positions.
+// The slice can be any type slice, include struct slice.
+func (s *slice) Rotate(k int) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	length := sliceValue.Len()
+	if length <= 1 {
+		return nil
+	}
+
+	k = k % length
+	if k < 0 {
+		k = k + length
+	}
+	rotate(sliceValue, 0, length-1, k)
+	return nil
+}
+
+// Swap the elements at index1 and index2 of slice
+func (s *slice) Swap(index1, index2 int) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	if index1 < 0 || index1 >= sliceValue.Len() || index2 < 0 || index2 >= sliceValue.Len() {
+		return errors.New("index out of range!")
+	}
+
+	swap(sliceValue, index1, index2)
+	return nil
+}
+
+// Move the element at index from to index to, the elements between them are shifted by one position.
+func (s *slice) Move(from, to int) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	if from < 0 || from >= sliceValue.Len() || to < 0 || to >= sliceValue.Len() {
+		return errors.New("index out of range!")
+	}
+
+	if from < to {
+		// rotate left by one position
+		rotate(sliceValue, from, to, to-from)
+	} else if from > to {
+		// rotate right by one position
+		rotate(sliceValue, to, from, 1)
+	}
+	return nil
+}
+
+// reverse the elements in [lowIndex, highIndex] of slice
+func reverse(sliceValue reflect.Value, lowIndex, highIndex int) {
+	for lowIndex < highIndex {
+		swap(sliceValue, lowIndex, highIndex)
+		lowIndex++
+		highIndex--
+	}
+}
+
+// rotate the elements in [lowIndex, highIndex] of slice to the right by k positions, 0 <= k <= highIndex-lowIndex+1.
+func rotate(sliceValue reflect.Value, lowIndex, highIndex, k int) {
+	if k == 0 || k == highIndex-lowIndex+1 {
+		return
+	}
+
+	reverse(sliceValue, lowIndex, highIndex)
+	reverse(sliceValue, lowIndex, lowIndex+k-1)
+	reverse(sliceValue, lowIndex+k, highIndex)
+}
@@ -0,0 +1,87 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceReverse(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Reverse(); err != nil || !equalSlices(values, []int{5, 4, 3, 2, 1}) {
+		t.Fatal("Failed to reverse slice! actual: ", values, " error: ", err)
+	}
+
+	students := []student{{name: "1"}, {name: "2"}}
+	if err := Slice(&students).Reverse(); err != nil || students[0].name != "2" || students[1].name != "1" {
+		t.Fatal("Failed to reverse struct slice! actual: ", students, " error: ", err)
+	}
+
+	empty := []int{}
+	if err := Slice(&empty).Reverse(); err != nil {
+		t.Fatal("Failed to reverse empty slice! error: ", err)
+	}
+	if err := Slice(values).Reverse(); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceRotate(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Rotate(2); err != nil || !equalSlices(values, []int{4, 5, 1, 2, 3}) {
+		t.Fatal("Failed to rotate slice to the right! actual: ", values, " error: ", err)
+	}
+
+	values = []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Rotate(-2); err != nil || !equalSlices(values, []int{3, 4, 5, 1, 2}) {
+		t.Fatal("Failed to rotate slice to the left! actual: ", values, " error: ", err)
+	}
+
+	values = []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Rotate(12); err != nil || !equalSlices(values, []int{4, 5, 1, 2, 3}) {
+		t.Fatal("Failed to rotate slice by k greater than length! actual: ", values, " error: ", err)
+	}
+
+	values = []int{1, 2, 3}
+	if err := Slice(&values).Rotate(-3); err != nil || !equalSlices(values, []int{1, 2, 3}) {
+		t.Fatal("Rotate slice by its length should not change it! actual: ", values, " error: ", err)
+	}
+
+	empty := []int{}
+	if err := Slice(&empty).Rotate(3); err != nil {
+		t.Fatal("Failed to rotate empty slice! error: ", err)
+	}
+}
+
+func TestSliceSwap(t *testing.T) {
+	values := []int{1, 2, 3}
+	if err := Slice(&values).Swap(0, 2); err != nil || !equalSlices(values, []int{3, 2, 1}) {
+		t.Fatal("Failed to swap elements! actual: ", values, " error: ", err)
+	}
+
+	if err := Slice(&values).Swap(0, 3); err == nil || err.Error() != "index out of range!" {
+		t.Fatal("It should be index out of range error when swapping out of range element! error: ", err)
+	}
+	if err := Slice(&values).Swap(-1, 0); err == nil {
+		t.Fatal("It should be error when swapping negative index!")
+	}
+}
+
+func TestSliceMove(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Move(1, 3); err != nil || !equalSlices(values, []int{1, 3, 4, 2, 5}) {
+		t.Fatal("Failed to move element forward! actual: ", values, " error: ", err)
+	}
+
+	values = []int{1, 2, 3, 4, 5}
+	if err := Slice(&values).Move(4, 0); err != nil || !equalSlices(values, []int{5, 1, 2, 3, 4}) {
+		t.Fatal("Failed to move element backward! actual: ", values, " error: ", err)
+	}
+
+	values = []int{1, 2, 3}
+	if err := Slice(&values).Move(1, 1); err != nil || !equalSlices(values, []int{1, 2, 3}) {
+		t.Fatal("Move element to the same index should not change slice! actual: ", values, " error: ", err)
+	}
+
+	if err := Slice(&values).Move(0, 3); err == nil || err.Error() != "index out of range!" {
+		t.Fatal("It should be index out of range error when moving to out of range index! error: ", err)
+	}
+}
@@ -0,0 +1,123 @@
+package generic
+
+import (
+	"reflect"
+)
+
+// Find element in the sorted slice by binary search algorithm.
+// The slice should be sorted in ascending order by QuickSort, and it supports the same types as QuickSort.
+// If the element is found, return its index and true,
+// otherwise return the index where the element should be inserted and false.
+// If there are equal elements, the index of the first one is returned.
+func (s *slice) BinarySearch(elem interface{}) (int, bool, error) {
+	return s.BinarySearchBy(elem, defaultCompareFuncName)
+}
+
+// Basicly it is same as BinarySearch function.
+// It just give you choice to decide the compare function which is used by struct
+func (s *slice) BinarySearchBy(elem interface{}, compareFuncName string) (int, bool, error) {
+	sliceValue, elemValue, compareFunc, err := s.prepareSearch(elem, compareFuncName)
+	if err != nil {
+		return -1, false, err
+	}
+
+	index := lowerBound(sliceValue, elemValue, compareFunc)
+	found := index < sliceValue.Len() && compareFunc(sliceValue.Index(index), elemValue) == 0
+	return index, found, nil
+}
+
+// Return the index of the first element which is not less than elem in the sorted slice.
+// The slice should be sorted in ascending order by QuickSort.
+func (s *slice) LowerBound(elem interface{}) (int, error) {
+	sliceValue, elemValue, compareFunc, err := s.prepareSearch(elem, defaultCompareFuncName)
+	if err != nil {
+		return -1, err
+	}
+
+	return lowerBound(sliceValue, elemValue, compareFunc), nil
+}
+
+// Return the index of the first element which is greater than elem in the sorted slice.
+// The slice should be sorted in ascending order by QuickSort.
+func (s *slice) UpperBound(elem interface{}) (int, error) {
+	sliceValue, elemValue, compareFunc, err := s.prepareSearch(elem, defaultCompareFuncName)
+	if err != nil {
+		return -1, err
+	}
+
+	return upperBound(sliceValue, elemValue, compareFunc), nil
+}
+
+// check the slice and the element, then return the slice value, the element value and the function to compare elements.
+func (s *slice) prepareSearch(elem interface{}, compareFuncName string) (reflect.Value, reflect.Value, compareFunc, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, nil, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	elemValue, err := checkElem(sliceValue.Type().Elem(), elem)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, nil, err
+	}
+
+	if err = checkSortInterface(sliceValue.Type(), compareFuncName); err != nil {
+		return reflect.Value{}, reflect.Value{}, nil, err
+	}
+	compareFunc, err := checkTypeOfSort(sliceValue.Type().Elem(), compareFuncName)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, nil, err
+	}
+
+	return sliceValue, elemValue, compareFunc, nil
+}
+
+// return the index of the first element which is not less than elemValue
+func lowerBound(sliceValue, elemValue reflect.Value, compareFunc compareFunc) int {
+	lowIndex, highIndex := 0, sliceValue.Len()
+	for lowIndex < highIndex {
+		middleIndex := lowIndex + (highIndex-lowIndex)/2
+		if compareFunc(sliceValue.Index(middleIndex), elemValue) < 0 {
+			lowIndex = middleIndex + 1
+		} else {
+			highIndex = middleIndex
+		}
+	}
+	return lowIndex
+}
+
+// return the index of the first element which is greater than elemValue
+func upperBound(sliceValue, elemValue reflect.Value, compareFunc compareFunc) int {
+	lowIndex, highIndex := 0, sliceValue.Len()
+	for lowIndex < highIndex {
+		middleIndex := lowIndex + (highIndex-lowIndex)/2
+		if compareFunc(sliceValue.Index(middleIndex), elemValue) <= 0 {
+			lowIndex = middleIndex + 1
+		} else {
+			highIndex = middleIndex
+		}
+	}
+	return lowIndex
+}
+
+// Insert element into the sorted slice and keep the slice sorted.
+// The slice should be sorted in ascending order by QuickSort, and it supports the same types as QuickSort.
+// The element is inserted after the equal elements, and its index is returned.
+func (s *slice) InsertSorted(elem interface{}) (int, error) {
+	return s.InsertSortedBy(elem, defaultCompareFuncName)
+}
+
+// Basicly it is same as InsertSorted function.
+// It just give you choice to decide the compare function which is used by struct
+func (s *slice) InsertSortedBy(elem interface{}, compareFuncName string) (int, error) {
+	sliceValue, elemValue, compareFunc, err := s.prepareSearch(elem, compareFuncName)
+	if err != nil {
+		return -1, err
+	}
+
+	index := upperBound(sliceValue, elemValue, compareFunc)
+	sliceValue.Set(reflect.Append(sliceValue, elemValue))
+	reflect.Copy(sliceValue.Slice(index+1, sliceValue.Len()), sliceValue.Slice(index, sliceValue.Len()-1))
+	sliceValue.Index(index).Set(elemValue)
+	return index, nil
+}
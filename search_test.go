@@ -0,0 +1,114 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceBinarySearch(t *testing.T) {
+	values := []int{1, 3, 3, 3, 5, 7}
+	index, found, err := Slice(&values).BinarySearch(3)
+	if err != nil || !found || index != 1 {
+		t.Fatal("Failed to binary search the first equal element! index: ", index, " error: ", err)
+	}
+
+	index, found, err = Slice(&values).BinarySearch(6)
+	if err != nil || found || index != 5 {
+		t.Fatal("Binary search should return insertion point when element is not found! index: ", index)
+	}
+
+	index, found, err = Slice(&values).BinarySearch(100)
+	if err != nil || found || index != 6 {
+		t.Fatal("Binary search should return length of slice when element is greater than all! index: ", index)
+	}
+
+	empty := []int{}
+	index, found, err = Slice(&empty).BinarySearch(1)
+	if err != nil || found || index != 0 {
+		t.Fatal("Binary search empty slice should return 0! index: ", index)
+	}
+
+	if _, _, err = Slice(&values).BinarySearch(int64(3)); err == nil {
+		t.Fatal("It should be error when the element type is different from slice!")
+	}
+	if _, _, err = Slice(values).BinarySearch(3); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceBinarySearchBy(t *testing.T) {
+	students := []student{{name: "1", age: 11}, {name: "2", age: 14}, {name: "3", age: 15}}
+	index, found, err := Slice(&students).BinarySearchBy(student{age: 14}, "CompareByAge")
+	if err != nil || !found || index != 1 {
+		t.Fatal("Failed to binary search struct by CompareByAge! index: ", index, " error: ", err)
+	}
+
+	index, found, err = Slice(&students).BinarySearchBy(student{age: 12}, "CompareByAge")
+	if err != nil || found || index != 1 {
+		t.Fatal("Binary search struct should return insertion point when element is not found! index: ", index)
+	}
+
+	if _, _, err = Slice(&students).BinarySearchBy(student{age: 12}, "CompareByName"); err == nil {
+		t.Fatal("It should be error when the compare function doesn't exist!")
+	}
+}
+
+func TestSliceLowerAndUpperBound(t *testing.T) {
+	values := []string{"a", "b", "b", "b", "c"}
+	lower, err := Slice(&values).LowerBound("b")
+	if err != nil || lower != 1 {
+		t.Fatal("Failed to get lower bound! index: ", lower, " error: ", err)
+	}
+
+	upper, err := Slice(&values).UpperBound("b")
+	if err != nil || upper != 4 {
+		t.Fatal("Failed to get upper bound! index: ", upper, " error: ", err)
+	}
+
+	students := []*student{nil, {name: "1", age: 11}}
+	lower, err = Slice(&students).LowerBound(nil)
+	if err != nil || lower != 0 {
+		t.Fatal("Failed to get lower bound of nil in pointer slice! index: ", lower, " error: ", err)
+	}
+	upper, err = Slice(&students).UpperBound(nil)
+	if err != nil || upper != 1 {
+		t.Fatal("Failed to get upper bound of nil in pointer slice! index: ", upper, " error: ", err)
+	}
+}
+
+func TestSliceInsertSorted(t *testing.T) {
+	values := []int{}
+	for _, value := range []int{5, 1, 3, 3, 9, 0} {
+		if _, err := Slice(&values).InsertSorted(value); err != nil {
+			t.Fatal("Failed to insert element into sorted slice! error: ", err)
+		}
+	}
+	expected := []int{0, 1, 3, 3, 5, 9}
+	if len(values) != len(expected) {
+		t.Fatal("After insert elements into sorted slice, the length should be right! actual: ", values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatal("After insert elements into sorted slice, the elements should be ordered! actual: ", values)
+		}
+	}
+
+	index, err := Slice(&values).InsertSorted(4)
+	if err != nil || index != 4 || values[4] != 4 {
+		t.Fatal("Insert sorted should return the index of inserted element! index: ", index)
+	}
+
+	if _, err = Slice(&values).InsertSorted("4"); err == nil {
+		t.Fatal("It should be error when the element type is different from slice!")
+	}
+}
+
+func TestSliceInsertSortedBy(t *testing.T) {
+	students := []student{{name: "1", age: 11}, {name: "2", age: 14}}
+	index, err := Slice(&students).InsertSortedBy(student{name: "3", age: 11}, "CompareByAge")
+	if err != nil || index != 1 {
+		t.Fatal("Failed to insert struct into sorted slice by CompareByAge! index: ", index, " error: ", err)
+	}
+	if len(students) != 3 || students[0].name != "1" || students[1].name != "3" || students[2].name != "2" {
+		t.Fatal("After insert struct into sorted slice, the equal elements should keep insertion order! actual: ", students)
+	}
+}
@@ -2,11 +2,16 @@ package generic
 
 import (
 	"errors"
-	"fmt"
+	"math"
+	"math/bits"
 	"reflect"
+	"sort"
 	"strings"
 )
 
+// the compare function name used by QuickSort
+const defaultCompareFuncName = "Compare"
+
 type slice struct {
 	slicePtr interface{}
 }
@@ -32,6 +37,68 @@ func (s *slice) RemoveAt(index int) error {
 	return nil
 }
 
+// Remove elements of slice in range [from, to), the vacated tail of slice is zeroed.
+func (s *slice) RemoveRange(from, to int) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	length := sliceValue.Len()
+	if from < 0 || to > length || from > to {
+		return errors.New("index out of range!")
+	}
+	reflect.Copy(sliceValue.Slice(from, length), sliceValue.Slice(to, length))
+	shrink(sliceValue, length-(to-from))
+	return nil
+}
+
+// Remove elements at indices of slice, the indices can be unsorted and duplicate.
+// All indices are checked before removing, so the slice is not changed if any index is out of range.
+// The vacated tail of slice is zeroed.
+func (s *slice) RemoveAtIndices(indices ...int) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	length := sliceValue.Len()
+	for _, index := range indices {
+		if index < 0 || index >= length {
+			return errors.New("index out of range!")
+		}
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	sorted := append([]int(nil), indices...)
+	sort.Ints(sorted)
+	unique := sorted[:1]
+	for _, index := range sorted[1:] {
+		if index != unique[len(unique)-1] {
+			unique = append(unique, index)
+		}
+	}
+	sorted = unique
+
+	// move every kept run between removed indices to the front once
+	kept := sorted[0]
+	for i, index := range sorted {
+		end := length
+		if i+1 < len(sorted) {
+			end = sorted[i+1]
+		}
+		if index+1 < end {
+			kept += reflect.Copy(sliceValue.Slice(kept, end), sliceValue.Slice(index+1, end))
+		}
+	}
+	shrink(sliceValue, kept)
+	return nil
+}
+
 // Remove element of slice
 func (s *slice) Remove(elem interface{}) error {
 
@@ -61,6 +128,80 @@ func (s *slice) RemoveBy(equal func(interface{}) bool) error {
 	return nil
 }
 
+// Remove all elements of slice which are equal to elem in one pass, and return the count of removed elements.
+// The vacated tail of slice is zeroed, so the removed pointers can be garbage collected.
+// If elem is nil, the nil elements of pointer, interface, map, slice, chan and func slice are removed.
+func (s *slice) RemoveAll(elem interface{}) (int, error) {
+	return s.removeIf(func(value reflect.Value) bool {
+		if elem == nil {
+			switch value.Kind() {
+			case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+				return value.IsNil()
+			}
+			return false
+		}
+		return reflect.DeepEqual(value.Interface(), elem)
+	})
+}
+
+// Remove all elements of slice when pred function return true in one pass, and return the count of removed elements.
+// The vacated tail of slice is zeroed, so the removed pointers can be garbage collected.
+func (s *slice) RemoveAllBy(pred func(interface{}) bool) (int, error) {
+	if pred == nil {
+		return 0, errors.New("pred function is nil!")
+	}
+
+	return s.removeIf(func(value reflect.Value) bool {
+		return pred(value.Interface())
+	})
+}
+
+// Keep the elements of slice when pred function return true and remove the others in one pass,
+// and return the count of removed elements.
+// The vacated tail of slice is zeroed, so the removed pointers can be garbage collected.
+func (s *slice) RetainBy(pred func(interface{}) bool) (int, error) {
+	if pred == nil {
+		return 0, errors.New("pred function is nil!")
+	}
+
+	return s.removeIf(func(value reflect.Value) bool {
+		return !pred(value.Interface())
+	})
+}
+
+// compact the elements of slice which should not be removed to the front, then zero the tail and shrink the slice.
+func (s *slice) removeIf(remove func(reflect.Value) bool) (int, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return 0, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	length := sliceValue.Len()
+	kept := 0
+	for index := 0; index < length; index++ {
+		if remove(sliceValue.Index(index)) {
+			continue
+		}
+		if kept != index {
+			sliceValue.Index(kept).Set(sliceValue.Index(index))
+		}
+		kept++
+	}
+
+	shrink(sliceValue, kept)
+	return length - kept, nil
+}
+
+// zero the elements from length of slice, and set the length of slice
+func shrink(sliceValue reflect.Value, length int) {
+	zero := reflect.Zero(sliceValue.Type().Elem())
+	for index := length; index < sliceValue.Len(); index++ {
+		sliceValue.Index(index).Set(zero)
+	}
+	sliceValue.Set(sliceValue.Slice(0, length))
+}
+
 // Find element of slice
 func (s *slice) Find(elem interface{}) (int, error) {
 	err := s.checkSlice()
@@ -150,9 +291,35 @@ func (s *slice) checkSlice() error {
 	return nil
 }
 
+// check the element can be stored in the slice whose element type is elemType,
+// and return the element value. nil is converted to the zero value of pointer, interface, map, slice, chan and func types.
+func checkElem(elemType reflect.Type, elem interface{}) (reflect.Value, error) {
+	if elem == nil {
+		switch elemType.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return reflect.Zero(elemType), nil
+		}
+		return reflect.Value{}, errors.New("element type mismatch: nil is not " + elemType.String())
+	}
+
+	elemValue := reflect.ValueOf(elem)
+	if !elemValue.Type().AssignableTo(elemType) {
+		return reflect.Value{}, errors.New("element type mismatch: " + elemValue.Type().String() + " is not " + elemType.String())
+	}
+	if elemValue.Type() != elemType {
+		elemValue = elemValue.Convert(elemType)
+	}
+	return elemValue, nil
+}
+
 // sort slice by quick sort algorithm
-// support slice of all int and uint types
-// and support stuct which has the compare function, function name should be "Compare", and return int. such as
+// support slice of all int, uint, float and string types, string is sorted in lexicographic order
+// and support pointers to these types, the nil pointers are ordered before the others.
+// and support stuct or pointer to struct which has the compare function, function name should be "Compare", and return int.
+// the compare function can be declared on value or pointer receiver, and it can be declared on any named type.
+// The type without compare function can declare "Less" function which returns bool instead,
+// and the slice type which implements sort.Interface is sorted by sort.Sort,
+// the other functions which compare elements, such as BinarySearch, return error for it if its element has no compare function. such as
 // type student stuct {
 // 	age int
 // }
@@ -176,27 +343,102 @@ func (s *slice) QuickSort() error {
 	if sliceValue.Len() <= 1 {
 		return nil
 	}
-	compareFuncName := "Compare"
-	err = checkTypeOfSort(sliceValue.Index(0), compareFuncName)
+	// the slice type which implements sort.Interface is sorted by itself
+	if sortable, ok := sliceValue.Interface().(sort.Interface); ok {
+		sort.Sort(sortable)
+		return nil
+	}
+	// the fixed-width number slice is sorted by radix sort, which is much faster
+	if radixSortTyped(s.slicePtr) {
+		return nil
+	}
+	compareFunc, err := checkTypeOfSort(sliceValue.Type().Elem(), defaultCompareFuncName)
 	if err != nil {
 		return err
 	}
-	quickSort(sliceValue, 0, sliceValue.Len()-1, compareFuncName)
+	quickSort(sliceValue, 0, sliceValue.Len()-1, compareFunc)
 	return nil
 }
 
+// check the slice and the type of element for sorting, then return the slice value and the function to compare elements.
+func (s *slice) prepareSort(compareFuncName string) (reflect.Value, compareFunc, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	if err = checkSortInterface(sliceValue.Type(), compareFuncName); err != nil {
+		return reflect.Value{}, nil, err
+	}
+	compareFunc, err := checkTypeOfSort(sliceValue.Type().Elem(), compareFuncName)
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	return sliceValue, compareFunc, nil
+}
+
+var sortInterfaceType = reflect.TypeOf((*sort.Interface)(nil)).Elem()
+
+// The slice type which implements sort.Interface is sorted by itself in QuickSort, but its order can't be used
+// to compare the elements of other slices or the element out of slice, so the element should have compare function.
+// Otherwise return error instead of comparing the elements by their kind silently.
+func checkSortInterface(sliceType reflect.Type, funcName string) error {
+	if !sliceType.Implements(sortInterfaceType) {
+		return nil
+	}
+
+	valueType := sliceType.Elem()
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+	if hasMethod(valueType, funcName) || (funcName == defaultCompareFuncName && hasMethod(valueType, lessFuncName)) {
+		return nil
+	}
+	return errors.New("unsupport sort.Interface type: " + sliceType.String() + ", its element has no compare function!")
+}
+
+// check the type of element for sorting, and return the function to compare elements.
 // if the type is not supported, or the struct doesn't have compare function, then return error.
-func checkTypeOfSort(elem reflect.Value, funcName string) error {
-	switch elem.Type().Kind() {
+func checkTypeOfSort(elemType reflect.Type, funcName string) (compareFunc, error) {
+	compareFunc, err := elemCompareFunc(elemType, funcName)
+	if err != nil {
+		return nil, err
+	}
+
+	if elemType.Kind() == reflect.Ptr {
+		return compareNils(compareFunc, false), nil
+	}
+	return compareFunc, nil
+}
+
+// return the function to compare elements, the elements passed to it should not be nil pointers.
+// The compare function declared on the type takes precedence over the kind of type,
+// and the type with "Less" method is compared by it if funcName is the default one.
+func elemCompareFunc(elemType reflect.Type, funcName string) (compareFunc, error) {
+	valueType, pointerElem := elemType, false
+	if elemType.Kind() == reflect.Ptr {
+		valueType, pointerElem = elemType.Elem(), true
+	}
+	if hasMethod(valueType, funcName) {
+		return compareByMethod(valueType, funcName, pointerElem)
+	}
+	if funcName == defaultCompareFuncName && hasMethod(valueType, lessFuncName) {
+		return compareByLess(valueType, pointerElem)
+	}
+
+	switch elemType.Kind() {
 	case reflect.Struct:
-		funcValue := elem.MethodByName(funcName)
-		if funcValue.IsNil() {
-			return errors.New("no compare function!")
+		return compareByTags(elemType, funcName, false)
+	case reflect.Ptr:
+		if elemType.Elem().Kind() == reflect.Struct {
+			return compareByTags(elemType.Elem(), funcName, true)
 		}
-		if !strings.HasSuffix(funcValue.Type().String(), "int") {
-			return errors.New("compare function should return int!")
+		compareFunc, err := checkTypeOfSort(elemType.Elem(), funcName)
+		if err != nil {
+			return nil, err
 		}
-		break
+		return compareByElem(compareFunc), nil
 	case reflect.Int8:
 		fallthrough
 	case reflect.Int16:
@@ -220,13 +462,12 @@ func checkTypeOfSort(elem reflect.Value, funcName string) error {
 	case reflect.Float32:
 		fallthrough
 	case reflect.Float64:
-		break
-	default:
-		fmt.Println(elem.Type().Kind())
-		return errors.New("unsupport type: " + elem.Type().Kind().String())
+		fallthrough
+	case reflect.String:
+		return compare, nil
 	}
 
-	return nil
+	return nil, errors.New("unsupport type: " + elemType.Kind().String())
 }
 
 // Basicly it is same as QuickSort function.
@@ -242,16 +483,25 @@ func (s *slice) QuickSortBy(compareFuncName string) error {
 	if sliceValue.Len() <= 1 {
 		return nil
 	}
-	if err = checkTypeOfSort(sliceValue.Index(0), compareFuncName); err != nil {
+	if err = checkSortInterface(sliceValue.Type(), compareFuncName); err != nil {
+		return err
+	}
+	compareFunc, err := checkTypeOfSort(sliceValue.Type().Elem(), compareFuncName)
+	if err != nil {
 		return err
 	}
 
-	quickSort(sliceValue, 0, sliceValue.Len()-1, compareFuncName)
+	quickSort(sliceValue, 0, sliceValue.Len()-1, compareFunc)
 	return nil
 }
 
+// the range shorter than it is sorted by insertion sort in quickSort
+const insertionSortThreshold = 12
+
 // the internal function for implementing quick sort algorithm.
-func quickSort(slice reflect.Value, lowIndex, highIndex int, compareFuncName string) {
+// It is an introsort, the pivot is the median of three elements, the short range is sorted by insertion sort,
+// and the range is sorted by heap sort when the recursion is too deep, so the worst case is O(n*log(n)).
+func quickSort(slice reflect.Value, lowIndex, highIndex int, compareFunc compareFunc) {
 	if lowIndex < 0 {
 		lowIndex = 0
 	}
@@ -262,25 +512,108 @@ func quickSort(slice reflect.Value, lowIndex, highIndex int, compareFuncName str
 		return
 	}
 
-	firstIndex := lowIndex
-	lastIndex := highIndex
-	key := clone(slice.Index(firstIndex))
+	introSort(slice, lowIndex, highIndex, 2*bits.Len(uint(highIndex-lowIndex+1)), compareFunc)
+}
+
+// sort the elements in [lowIndex, highIndex], it turns to heap sort when depth is 0.
+func introSort(slice reflect.Value, lowIndex, highIndex, depth int, compareFunc compareFunc) {
+	for highIndex-lowIndex+1 > insertionSortThreshold {
+		if depth == 0 {
+			heapSort(slice, lowIndex, highIndex, compareFunc)
+			return
+		}
+		depth--
+
+		lessIndex, greaterIndex := partition3(slice, lowIndex, highIndex, compareFunc)
+		// recurse into the shorter part and loop on the longer part, so the recursion depth is O(log(n))
+		if lessIndex-lowIndex < highIndex-greaterIndex {
+			introSort(slice, lowIndex, lessIndex-1, depth, compareFunc)
+			lowIndex = greaterIndex + 1
+		} else {
+			introSort(slice, greaterIndex+1, highIndex, depth, compareFunc)
+			highIndex = lessIndex - 1
+		}
+	}
+
+	insertionSort(slice, lowIndex, highIndex, compareFunc)
+}
+
+// sort the elements in [lowIndex, highIndex] by insertion sort algorithm
+func insertionSort(slice reflect.Value, lowIndex, highIndex int, compareFunc compareFunc) {
+	for index := lowIndex + 1; index <= highIndex; index++ {
+		for current := index; current > lowIndex && compareFunc(slice.Index(current-1), slice.Index(current)) > 0; current-- {
+			swap(slice, current-1, current)
+		}
+	}
+}
 
-	for firstIndex < lastIndex {
+// sort the elements in [lowIndex, highIndex] by heap sort algorithm
+func heapSort(slice reflect.Value, lowIndex, highIndex int, compareFunc compareFunc) {
+	heap := slice.Slice(lowIndex, highIndex+1)
+	size := heap.Len()
+	for root := size/2 - 1; root >= 0; root-- {
+		siftDown(heap, root, size, compareFunc)
+	}
+	for size = size - 1; size > 0; size-- {
+		swap(heap, 0, size)
+		siftDown(heap, 0, size, compareFunc)
+	}
+}
 
-		for firstIndex < lastIndex && compare(slice.Index(lastIndex), key, compareFuncName) >= 0 {
-			lastIndex = lastIndex - 1
+// partition the elements in [lowIndex, highIndex] into three parts by the median of three elements as pivot,
+// the elements less than pivot, equal to pivot and greater than pivot.
+// return the first and the last index of the elements equal to pivot.
+func partition3(slice reflect.Value, lowIndex, highIndex int, compareFunc compareFunc) (int, int) {
+	pivot := clone(slice.Index(medianOfThree(slice, lowIndex, lowIndex+(highIndex-lowIndex)/2, highIndex, compareFunc)))
+
+	lessIndex, index, greaterIndex := lowIndex, lowIndex, highIndex
+	for index <= greaterIndex {
+		result := compareFunc(slice.Index(index), pivot)
+		if result < 0 {
+			swap(slice, lessIndex, index)
+			lessIndex++
+			index++
+		} else if result > 0 {
+			swap(slice, index, greaterIndex)
+			greaterIndex--
+		} else {
+			index++
 		}
-		swap(slice, firstIndex, lastIndex)
+	}
+	return lessIndex, greaterIndex
+}
 
-		for firstIndex < lastIndex && compare(slice.Index(firstIndex), key, compareFuncName) <= 0 {
-			firstIndex = firstIndex + 1
+// return the index of the median one of the three elements
+func medianOfThree(slice reflect.Value, index1, index2, index3 int, compareFunc compareFunc) int {
+	if compareFunc(slice.Index(index1), slice.Index(index2)) > 0 {
+		index1, index2 = index2, index1
+	}
+	if compareFunc(slice.Index(index2), slice.Index(index3)) > 0 {
+		index2 = index3
+		if compareFunc(slice.Index(index1), slice.Index(index2)) > 0 {
+			index2 = index1
 		}
-		swap(slice, firstIndex, lastIndex)
 	}
+	return index2
+}
 
-	quickSort(slice, lowIndex, firstIndex-1, compareFuncName)
-	quickSort(slice, lastIndex+1, highIndex, compareFuncName)
+// keep the max heap property of the elements in [0, size) from root,
+// the element is greater than its children.
+func siftDown(heap reflect.Value, root, size int, compareFunc compareFunc) {
+	for {
+		child := 2*root + 1
+		if child >= size {
+			return
+		}
+		if child+1 < size && compareFunc(heap.Index(child), heap.Index(child+1)) < 0 {
+			child++
+		}
+		if compareFunc(heap.Index(root), heap.Index(child)) >= 0 {
+			return
+		}
+		swap(heap, root, child)
+		root = child
+	}
 }
 
 func clone(value reflect.Value) reflect.Value {
@@ -289,12 +622,153 @@ func clone(value reflect.Value) reflect.Value {
 	return newValue
 }
 
+// the function type used by the sort algorithms to compare two elements,
+// the return value has the same meaning as compare function.
+type compareFunc func(val1, val2 reflect.Value) int
+
+// the method name of type which returns true if it is less than the other
+const lessFuncName = "Less"
+
+// return true if the method is declared on the value or pointer receiver of valueType
+func hasMethod(valueType reflect.Type, methodName string) bool {
+	_, ok := reflect.PtrTo(valueType).MethodByName(methodName)
+	return ok
+}
+
+// make a compareFunc which compares elements by the compare method of type, such as struct or named string.
+// The method can be declared on value or pointer receiver, and its parameter can be the type or pointer to the type.
+// If pointerElem is true, the elements are pointers to the type, otherwise they are the type.
+func compareByMethod(valueType reflect.Type, compareFuncName string, pointerElem bool) (compareFunc, error) {
+	callMethod, err := methodCaller(valueType, compareFuncName, pointerElem)
+	if err != nil {
+		return nil, err
+	}
+	if outType := callMethod.methodType.Out(0); !isIntKind(outType.Kind()) {
+		return nil, errors.New("compare function should return int!")
+	}
+
+	return func(val1, val2 reflect.Value) int {
+		return int(callMethod.call(val1, val2).Int())
+	}, nil
+}
+
+// make a compareFunc which compares elements by the "Less" method of type, it returns true if it is less than the other.
+// The method can be declared on value or pointer receiver, and its parameter can be the type or pointer to the type.
+func compareByLess(valueType reflect.Type, pointerElem bool) (compareFunc, error) {
+	callMethod, err := methodCaller(valueType, lessFuncName, pointerElem)
+	if err != nil {
+		return nil, err
+	}
+	if outType := callMethod.methodType.Out(0); outType.Kind() != reflect.Bool {
+		return nil, errors.New("less function should return bool!")
+	}
+
+	return func(val1, val2 reflect.Value) int {
+		if callMethod.call(val1, val2).Bool() {
+			return -1
+		} else if callMethod.call(val2, val1).Bool() {
+			return 1
+		}
+		return 0
+	}, nil
+}
+
+// call the method of element with the other element as parameter
+type elemMethodCaller struct {
+	method       reflect.Method
+	methodType   reflect.Type
+	pointerElem  bool
+	pointerParam bool
+}
+
+// check the method accepts one parameter of valueType or pointer to valueType and returns one value,
+// then return the caller of the method.
+func methodCaller(valueType reflect.Type, methodName string, pointerElem bool) (*elemMethodCaller, error) {
+	method, ok := reflect.PtrTo(valueType).MethodByName(methodName)
+	if !ok {
+		return nil, errors.New("no compare function!")
+	}
+
+	methodType := method.Type
+	if methodType.NumIn() != 2 || (methodType.In(1) != valueType && methodType.In(1) != reflect.PtrTo(valueType)) {
+		return nil, errors.New("compare function should accept the element type!")
+	}
+	if methodType.NumOut() != 1 {
+		return nil, errors.New("compare function should return one value!")
+	}
+	return &elemMethodCaller{method, methodType, pointerElem, methodType.In(1).Kind() == reflect.Ptr}, nil
+}
+
+func (caller *elemMethodCaller) call(val1, val2 reflect.Value) reflect.Value {
+	arg := caller.toPointer(val2)
+	if !caller.pointerParam {
+		arg = arg.Elem()
+	}
+	return caller.method.Func.Call([]reflect.Value{caller.toPointer(val1), arg})[0]
+}
+
+// convert element to the pointer form
+func (caller *elemMethodCaller) toPointer(val reflect.Value) reflect.Value {
+	if caller.pointerElem {
+		return val
+	}
+	if !val.CanAddr() {
+		val = clone(val)
+	}
+	return val.Addr()
+}
+
+// make a compareFunc which compares the elements pointed by pointers
+func compareByElem(compareFunc compareFunc) compareFunc {
+	return func(val1, val2 reflect.Value) int {
+		return compareFunc(val1.Elem(), val2.Elem())
+	}
+}
+
+// make a compareFunc which orders nil pointers before the others, or after the others if nilsLast is true.
+// the nil pointers are equal to each other.
+func compareNils(compareFunc compareFunc, nilsLast bool) compareFunc {
+	nilResult := -1
+	if nilsLast {
+		nilResult = 1
+	}
+
+	return func(val1, val2 reflect.Value) int {
+		if val1.IsNil() || val2.IsNil() {
+			if val1.IsNil() && val2.IsNil() {
+				return 0
+			} else if val1.IsNil() {
+				return nilResult
+			}
+			return -nilResult
+		}
+		return compareFunc(val1, val2)
+	}
+}
+
+// make a compareFunc which reverses the order of compareFunc
+func compareReverse(compareFunc compareFunc) compareFunc {
+	return func(val1, val2 reflect.Value) int {
+		return compareFunc(val2, val1)
+	}
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
 // compare two elements of the slice
 // return value:
 // if value == 0, va1 is equal to val2,
 // if value < 0, va1 is less than val2,
 // if value > 0, va1 is greater than val2.
-func compare(val1, val2 reflect.Value, compareFuncName string) int {
+// The result is correct for the full range of all int and uint types.
+// For float types, NaN is less than any other number and equal to NaN, the same as sort.Float64Slice.
+func compare(val1, val2 reflect.Value) int {
 	switch val1.Type().Kind() {
 	case reflect.Int8:
 		fallthrough
@@ -305,8 +779,7 @@ func compare(val1, val2 reflect.Value, compareFuncName string) int {
 	case reflect.Int64:
 		fallthrough
 	case reflect.Int:
-		return int(val1.Int() - val2.Int())
-		break
+		return compareInt64(val1.Int(), val2.Int())
 	case reflect.Uint:
 		fallthrough
 	case reflect.Uint8:
@@ -316,26 +789,52 @@ func compare(val1, val2 reflect.Value, compareFuncName string) int {
 	case reflect.Uint32:
 		fallthrough
 	case reflect.Uint64:
-		return int(val1.Uint() - val2.Uint())
-		break
+		return compareUint64(val1.Uint(), val2.Uint())
 	case reflect.Float32:
 		fallthrough
 	case reflect.Float64:
-		v1 := val1.Float()
-		v2 := val2.Float()
-		if v1 < v2 {
-			return -1
-		} else if v1 == v2 {
+		return compareFloat64(val1.Float(), val2.Float())
+	case reflect.String:
+		return strings.Compare(val1.String(), val2.String())
+	}
+
+	return 0
+}
+
+func compareInt64(v1, v2 int64) int {
+	if v1 < v2 {
+		return -1
+	} else if v1 > v2 {
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(v1, v2 uint64) int {
+	if v1 < v2 {
+		return -1
+	} else if v1 > v2 {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(v1, v2 float64) int {
+	isNaN1, isNaN2 := math.IsNaN(v1), math.IsNaN(v2)
+	if isNaN1 || isNaN2 {
+		if isNaN1 && isNaN2 {
 			return 0
-		} else {
-			return 1
+		} else if isNaN1 {
+			return -1
 		}
-		break
-	default:
-		compareFuncValue := val1.MethodByName(compareFuncName)
-		return int(compareFuncValue.Call([]reflect.Value{val2})[0].Int())
+		return 1
 	}
 
+	if v1 < v2 {
+		return -1
+	} else if v1 > v2 {
+		return 1
+	}
 	return 0
 }
 
@@ -1,7 +1,9 @@
 package generic
 
 import (
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -615,3 +617,366 @@ func BenchmarkSliceQuickSort_Ints(b *testing.B) {
 	}
 	Slice(&values).QuickSort()
 }
+
+type teacher struct {
+	name string
+	age  int
+}
+
+func (t *teacher) Compare(other *teacher) int {
+	if t.age < other.age {
+		return -1
+	} else if t.age == other.age {
+		return 0
+	} else {
+		return 1
+	}
+}
+
+func TestSliceQuickSort_StructPointer(t *testing.T) {
+	students := []*student{{name: "1", age: 15}, nil, {name: "2", age: 11}, {name: "3", age: 14}, nil}
+	if err := Slice(&students).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort struct pointer slice! error: ", err)
+	}
+	if students[0] != nil || students[1] != nil {
+		t.Fatal("After quick sort struct pointer slice, the nil elements should be first! actual: ", students)
+	}
+	if students[2].age != 11 || students[3].age != 14 || students[4].age != 15 {
+		t.Fatal("After quick sort struct pointer slice, the elements should be ordered! actual: ", students)
+	}
+
+	students2 := []*student{{name: "1", age: 15}, {name: "2", age: 11}}
+	if err := Slice(&students2).QuickSortBy("CompareByAge"); err != nil {
+		t.Fatal("Failed to quick sort struct pointer slice by CompareByAge! error: ", err)
+	}
+	if students2[0].age != 11 || students2[1].age != 15 {
+		t.Fatal("After quick sort struct pointer slice by CompareByAge, the elements should be ordered! actual: ", students2)
+	}
+}
+
+func TestSliceQuickSort_PointerReceiver(t *testing.T) {
+	teachers := []teacher{{name: "1", age: 45}, {name: "2", age: 31}, {name: "3", age: 38}}
+	if err := Slice(&teachers).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort struct slice with pointer receiver compare function! error: ", err)
+	}
+	if teachers[0].age != 31 || teachers[1].age != 38 || teachers[2].age != 45 {
+		t.Fatal("After quick sort struct slice with pointer receiver compare function, the elements should be ordered! actual: ", teachers)
+	}
+
+	teachers2 := []*teacher{{name: "1", age: 45}, nil, {name: "2", age: 31}}
+	if err := Slice(&teachers2).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort struct pointer slice with pointer receiver compare function! error: ", err)
+	}
+	if teachers2[0] != nil || teachers2[1].age != 31 || teachers2[2].age != 45 {
+		t.Fatal("After quick sort struct pointer slice with pointer receiver compare function, the elements should be ordered! actual: ", teachers2)
+	}
+
+	if err := Slice(&teachers2).QuickSortBy("CompareByAge"); err == nil {
+		t.Fatal("It should be error when the compare function doesn't exist!")
+	}
+}
+
+func TestSliceQuickSort_IntPointer(t *testing.T) {
+	one, two, three := 1, 2, 3
+	values := []*int{&three, nil, &one, &two}
+	if err := Slice(&values).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort int pointer slice! error: ", err)
+	}
+	if values[0] != nil || *values[1] != 1 || *values[2] != 2 || *values[3] != 3 {
+		t.Fatal("After quick sort int pointer slice, the elements should be ordered! actual: ", values)
+	}
+}
+
+func TestSliceQuickSort_LargeOrderedInput(t *testing.T) {
+	count := 50000
+	ordered := []int{}
+	reversed := []int{}
+	equal := []int{}
+	organPipe := []int{}
+	for i := 0; i < count; i++ {
+		ordered = append(ordered, i)
+		reversed = append(reversed, count-i)
+		equal = append(equal, 7)
+		if i < count/2 {
+			organPipe = append(organPipe, i)
+		} else {
+			organPipe = append(organPipe, count-i)
+		}
+	}
+
+	// QuickSort sorts int slice by radix sort, so QuickSortBy is used to test introsort
+	for _, values := range [][]int{ordered, reversed, equal, organPipe} {
+		if err := Slice(&values).QuickSortBy(defaultCompareFuncName); err != nil {
+			t.Fatal("Failed to quick sort large ordered slice! error: ", err)
+		}
+		for i := 0; i < len(values)-1; i++ {
+			if values[i] > values[i+1] {
+				t.Fatal("After quick sort large ordered slice, the elements should be ordered!")
+			}
+		}
+	}
+}
+
+func TestHeapSort(t *testing.T) {
+	values := []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0, 11, 10, 13, 12}
+	sliceValue := reflect.ValueOf(values)
+	// depth 0 turns to heap sort directly
+	introSort(sliceValue, 2, len(values)-1, 0, compare)
+	if values[0] != 9 || values[1] != 3 {
+		t.Fatal("Heap sort should not change the elements out of range! actual: ", values)
+	}
+	for i := 2; i < len(values)-1; i++ {
+		if values[i] > values[i+1] {
+			t.Fatal("After heap sort, the elements should be ordered! actual: ", values)
+		}
+	}
+}
+
+type version string
+
+// compare version by length first, so "10" is greater than "9"
+func (v version) Compare(other version) int {
+	if len(v) != len(other) {
+		return len(v) - len(other)
+	}
+	return strings.Compare(string(v), string(other))
+}
+
+type priority int
+
+// the higher priority is ordered first
+func (p priority) Compare(other priority) int {
+	return int(other) - int(p)
+}
+
+type task struct {
+	name     string
+	priority int
+}
+
+func (t task) Less(other task) bool {
+	return t.priority < other.priority
+}
+
+type byLength []string
+
+func (b byLength) Len() int           { return len(b) }
+func (b byLength) Less(i, j int) bool { return len(b[i]) < len(b[j]) }
+func (b byLength) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+func TestSliceQuickSort_NamedTypeCompare(t *testing.T) {
+	versions := []version{"10", "9", "100", "1"}
+	if err := Slice(&versions).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort named string slice with compare function! error: ", err)
+	}
+	if versions[0] != "1" || versions[1] != "9" || versions[2] != "10" || versions[3] != "100" {
+		t.Fatal("After quick sort named string slice, the compare function should take precedence! actual: ", versions)
+	}
+
+	priorities := []priority{1, 3, 2}
+	if err := Slice(&priorities).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort named int slice with compare function! error: ", err)
+	}
+	if priorities[0] != 3 || priorities[1] != 2 || priorities[2] != 1 {
+		t.Fatal("After quick sort named int slice, the compare function should take precedence! actual: ", priorities)
+	}
+}
+
+func TestSliceQuickSort_Less(t *testing.T) {
+	tasks := []task{{"a", 3}, {"b", 1}, {"c", 2}}
+	if err := Slice(&tasks).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort struct slice with less function! error: ", err)
+	}
+	if tasks[0].name != "b" || tasks[1].name != "c" || tasks[2].name != "a" {
+		t.Fatal("After quick sort struct slice with less function, the elements should be ordered! actual: ", tasks)
+	}
+
+	taskPtrs := []*task{{"a", 3}, nil, {"b", 1}}
+	if err := Slice(&taskPtrs).StableSort(); err != nil {
+		t.Fatal("Failed to stable sort struct pointer slice with less function! error: ", err)
+	}
+	if taskPtrs[0] != nil || taskPtrs[1].name != "b" || taskPtrs[2].name != "a" {
+		t.Fatal("After stable sort struct pointer slice with less function, the elements should be ordered! actual: ", taskPtrs)
+	}
+}
+
+func TestSliceQuickSort_SortInterface(t *testing.T) {
+	words := byLength{"ccc", "a", "bb"}
+	if err := Slice(&words).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort slice implements sort.Interface! error: ", err)
+	}
+	if words[0] != "a" || words[1] != "bb" || words[2] != "ccc" {
+		t.Fatal("After quick sort slice implements sort.Interface, the elements should be ordered by it! actual: ", words)
+	}
+
+	words = byLength{"bb", "ccc", "aa", "d"}
+	if err := Slice(&words).StableSort(); err != nil {
+		t.Fatal("Failed to stable sort slice implements sort.Interface! error: ", err)
+	}
+	if words[0] != "d" || words[1] != "bb" || words[2] != "aa" || words[3] != "ccc" {
+		t.Fatal("After stable sort slice implements sort.Interface, the equal elements should keep original order! actual: ", words)
+	}
+}
+
+type versions []version
+
+func (v versions) Len() int           { return len(v) }
+func (v versions) Less(i, j int) bool { return v[i].Compare(v[j]) < 0 }
+func (v versions) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+func TestSlicePrepareSort_SortInterface(t *testing.T) {
+	words := byLength{"b", "aa", "ccc"}
+	other := byLength{"dddd"}
+	dst := byLength{}
+	if _, _, err := Slice(&words).BinarySearch("aa"); err == nil {
+		t.Fatal("It should be error when binary search sort.Interface slice whose element has no compare function!")
+	}
+	if _, err := Slice(&words).InsertSorted("dd"); err == nil {
+		t.Fatal("It should be error when insert into sort.Interface slice whose element has no compare function!")
+	}
+	if err := Slice(&words).PartialSort(3); err == nil {
+		t.Fatal("It should be error when partial sort sort.Interface slice whose element has no compare function!")
+	}
+	if err := Slice(&words).TopK(1, &dst); err == nil {
+		t.Fatal("It should be error when get top k of sort.Interface slice whose element has no compare function!")
+	}
+	if err := Slice(&words).MergeSorted(&other, &dst); err == nil {
+		t.Fatal("It should be error when merge sort.Interface slices whose element has no compare function!")
+	}
+	if err := Slice(&words).ParallelSort(2); err == nil {
+		t.Fatal("It should be error when parallel sort sort.Interface slice whose element has no compare function!")
+	}
+	if err := Slice(&words).QuickSortDesc(); err == nil {
+		t.Fatal("It should be error when sort sort.Interface slice in descending order whose element has no compare function!")
+	}
+	if err := Slice(&words).QuickSortBy(defaultCompareFuncName); err == nil {
+		t.Fatal("It should be error when quick sort sort.Interface slice by compare function which doesn't exist!")
+	}
+	if words[0] != "b" || words[1] != "aa" || words[2] != "ccc" {
+		t.Fatal("The slice should not be changed when it is error! actual: ", words)
+	}
+
+	// the explicit ordering is allowed
+	if err := Slice(&words).SortWith(SortOptions{StringOrder: CaseInsensitiveOrder}); err != nil || words[0] != "aa" {
+		t.Fatal("Failed to sort sort.Interface slice with string order! actual: ", words, " error: ", err)
+	}
+
+	// the element has compare function
+	sorted := versions{"1", "9", "10"}
+	if index, found, err := Slice(&sorted).BinarySearch(version("10")); err != nil || !found || index != 2 {
+		t.Fatal("Failed to binary search sort.Interface slice whose element has compare function! actual: ", index, found, " error: ", err)
+	}
+	if err := Slice(&sorted).QuickSortDesc(); err != nil || sorted[0] != "10" || sorted[2] != "1" {
+		t.Fatal("Failed to sort sort.Interface slice whose element has compare function in descending order! actual: ", sorted, " error: ", err)
+	}
+}
+
+func TestSliceRemoveAll(t *testing.T) {
+	values := []byte{1, 2, 1, 3, 1}
+	count, err := Slice(&values).RemoveAll(byte(1))
+	if err != nil || count != 3 || len(values) != 2 || values[0] != 2 || values[1] != 3 {
+		t.Fatal("Failed to remove all equal elements! actual: ", values, " error: ", err)
+	}
+
+	count, err = Slice(&values).RemoveAll(int(2))
+	if err != nil || count != 0 || len(values) != 2 {
+		t.Fatal("should not remove byte value by int value!")
+	}
+
+	one, two := &student{name: "1"}, &student{name: "2"}
+	students := []*student{one, two, one}
+	count, err = Slice(&students).RemoveAll(one)
+	if err != nil || count != 2 || len(students) != 1 || students[0] != two {
+		t.Fatal("Failed to remove all equal pointers! actual: ", students, " error: ", err)
+	}
+	if tail := students[:3]; tail[1] != nil || tail[2] != nil {
+		t.Fatal("After remove all, the vacated tail should be zeroed!")
+	}
+
+	students = []*student{nil, one, nil, two, nil}
+	count, err = Slice(&students).RemoveAll(nil)
+	if err != nil || count != 3 || len(students) != 2 || students[0] != one || students[1] != two {
+		t.Fatal("Failed to remove all nil pointers! actual: ", students, " error: ", err)
+	}
+
+	count, err = Slice(&values).RemoveAll(nil)
+	if err != nil || count != 0 || len(values) != 2 {
+		t.Fatal("should not remove any element of byte slice by nil!")
+	}
+}
+
+func TestSliceRemoveAllBy(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6}
+	count, err := Slice(&values).RemoveAllBy(func(value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if err != nil || count != 3 || len(values) != 3 || values[0] != 1 || values[1] != 3 || values[2] != 5 {
+		t.Fatal("Failed to remove all elements through RemoveAllBy! actual: ", values, " error: ", err)
+	}
+	if tail := values[:6]; tail[3] != 0 || tail[4] != 0 || tail[5] != 0 {
+		t.Fatal("After remove all by, the vacated tail should be zeroed! actual: ", tail)
+	}
+
+	if _, err = Slice(&values).RemoveAllBy(nil); err == nil {
+		t.Fatal("It should be error when pred function is nil!")
+	}
+	if _, err = Slice(values).RemoveAllBy(func(value interface{}) bool { return true }); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceRetainBy(t *testing.T) {
+	students := []student{{name: "1", age: 10}, {name: "2", age: 20}, {name: "3", age: 30}}
+	count, err := Slice(&students).RetainBy(func(value interface{}) bool {
+		return value.(student).age >= 20
+	})
+	if err != nil || count != 1 || len(students) != 2 || students[0].name != "2" || students[1].name != "3" {
+		t.Fatal("Failed to retain elements through RetainBy! actual: ", students, " error: ", err)
+	}
+}
+
+func TestSliceRemoveRange(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4, 5}
+	if err := Slice(&values).RemoveRange(1, 4); err != nil || !equalSlices(values, []int{0, 4, 5}) {
+		t.Fatal("Failed to remove range of slice! actual: ", values, " error: ", err)
+	}
+	if tail := values[:6]; tail[3] != 0 || tail[4] != 0 || tail[5] != 0 {
+		t.Fatal("After remove range, the vacated tail should be zeroed! actual: ", tail)
+	}
+
+	if err := Slice(&values).RemoveRange(1, 1); err != nil || !equalSlices(values, []int{0, 4, 5}) {
+		t.Fatal("Failed to remove empty range of slice! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).RemoveRange(0, 3); err != nil || len(values) != 0 {
+		t.Fatal("Failed to remove whole range of slice! actual: ", values, " error: ", err)
+	}
+
+	values = []int{0, 1, 2}
+	for _, r := range [][2]int{{-1, 1}, {0, 4}, {2, 1}} {
+		if err := Slice(&values).RemoveRange(r[0], r[1]); err == nil {
+			t.Fatal("It should be error when the range is out of range! range: ", r)
+		}
+	}
+}
+
+func TestSliceRemoveAtIndices(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4, 5, 6}
+	if err := Slice(&values).RemoveAtIndices(5, 1, 1, 2, 6); err != nil || !equalSlices(values, []int{0, 3, 4}) {
+		t.Fatal("Failed to remove unsorted and duplicate indices of slice! actual: ", values, " error: ", err)
+	}
+	if tail := values[:7]; tail[3] != 0 || tail[6] != 0 {
+		t.Fatal("After remove at indices, the vacated tail should be zeroed! actual: ", tail)
+	}
+
+	if err := Slice(&values).RemoveAtIndices(); err != nil || !equalSlices(values, []int{0, 3, 4}) {
+		t.Fatal("Failed to remove no indices of slice! actual: ", values, " error: ", err)
+	}
+	if err := Slice(&values).RemoveAtIndices(0, 3); err == nil || !equalSlices(values, []int{0, 3, 4}) {
+		t.Fatal("It should be error and the slice should not be changed when index is out of range! actual: ", values)
+	}
+
+	students := []student{{name: "1"}, {name: "2"}, {name: "3"}}
+	if err := Slice(&students).RemoveAtIndices(0, 2); err != nil || len(students) != 1 || students[0].name != "2" {
+		t.Fatal("Failed to remove indices of struct slice! actual: ", students, " error: ", err)
+	}
+}
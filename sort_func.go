@@ -0,0 +1,61 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Sort slice by quick sort algorithm with the less function.
+// It supports slice of any type, the less function should return true if a is less than b. such as
+//
+//	names := []string{"b", "c", "a"}
+//	Slice(&names).SortFunc(func(a, b interface{}) bool {
+//		return a.(string) < b.(string)
+//	})
+func (s *slice) SortFunc(less func(a, b interface{}) bool) error {
+	if less == nil {
+		return errors.New("less function is nil!")
+	}
+
+	return s.SortCompare(func(a, b interface{}) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// Sort slice by quick sort algorithm with the compare function.
+// It supports slice of any type.
+// return value of cmp:
+// if value == 0, a is equal to b,
+// if value < 0, a is less than b,
+// if value > 0, a is greater than b.
+func (s *slice) SortCompare(cmp func(a, b interface{}) int) error {
+	if cmp == nil {
+		return errors.New("compare function is nil!")
+	}
+
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	slicePtrValue := reflect.ValueOf(s.slicePtr)
+	sliceValue := slicePtrValue.Elem()
+	if sliceValue.Len() <= 1 {
+		return nil
+	}
+
+	quickSort(sliceValue, 0, sliceValue.Len()-1, compareByInterface(cmp))
+	return nil
+}
+
+// make a compareFunc which passes elements to cmp as interface{}
+func compareByInterface(cmp func(a, b interface{}) int) compareFunc {
+	return func(val1, val2 reflect.Value) int {
+		return cmp(val1.Interface(), val2.Interface())
+	}
+}
@@ -0,0 +1,63 @@
+package generic
+
+import (
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+func TestSliceSortFunc(t *testing.T) {
+	names := []string{"d", "b", "c", "a"}
+	err := Slice(&names).SortFunc(func(a, b interface{}) bool {
+		return a.(string) < b.(string)
+	})
+	if err != nil {
+		t.Fatal("Failed to sort string slice by SortFunc! error: ", err)
+	}
+	if names[0] != "a" || names[1] != "b" || names[2] != "c" || names[3] != "d" {
+		t.Fatal("After sort string slice by SortFunc, the elements should be ordered! actual: ", names)
+	}
+
+	points := []*point{{3, 1}, {1, 2}, {2, 3}}
+	err = Slice(&points).SortFunc(func(a, b interface{}) bool {
+		return a.(*point).x < b.(*point).x
+	})
+	if err != nil {
+		t.Fatal("Failed to sort pointer slice by SortFunc! error: ", err)
+	}
+	if points[0].x != 1 || points[1].x != 2 || points[2].x != 3 {
+		t.Fatal("After sort pointer slice by SortFunc, the elements should be ordered! actual: ", points)
+	}
+
+	if err = Slice(&names).SortFunc(nil); err == nil {
+		t.Fatal("It should be error when less function is nil!")
+	}
+}
+
+func TestSliceSortCompare(t *testing.T) {
+	points := []point{{3, 1}, {1, 2}, {1, 1}, {2, 3}}
+	err := Slice(&points).SortCompare(func(a, b interface{}) int {
+		p1, p2 := a.(point), b.(point)
+		if p1.x != p2.x {
+			return p1.x - p2.x
+		}
+		return p1.y - p2.y
+	})
+	if err != nil {
+		t.Fatal("Failed to sort struct slice without method by SortCompare! error: ", err)
+	}
+	expected := []point{{1, 1}, {1, 2}, {2, 3}, {3, 1}}
+	for i := range expected {
+		if points[i] != expected[i] {
+			t.Fatal("After sort struct slice by SortCompare, the elements should be ordered! actual: ", points)
+		}
+	}
+
+	values := []int{1, 2}
+	err = Slice(values).SortCompare(func(a, b interface{}) int { return 0 })
+	if err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
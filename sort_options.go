@@ -0,0 +1,117 @@
+package generic
+
+import (
+	"reflect"
+)
+
+// The options to sort slice, the zero value sorts like QuickSort.
+type SortOptions struct {
+	// sort elements in descending order, default is ascending order
+	Descending bool
+	// keep the original order of equal elements, default is unstable
+	Stable bool
+	// order nil pointers after the others, default is before the others.
+	// It doesn't depend on Descending.
+	NilsLast bool
+	// the compare function name of struct, default is "Compare"
+	CompareFuncName string
+	// the order to compare strings, default is LexicalOrder.
+	// If it is not LexicalOrder, it takes precedence over the compare function of named string type.
+	StringOrder StringOrder
+	// the struct fields to compare elements one after another, such as "Department", "-Age", "Name".
+	// The field with prefix "-" is compared in descending order.
+	// If it is empty, elements are compared by compare function.
+	Fields []string
+	// allow Fields to contain unexported fields, they can only be compared by kind, not by compare function.
+	UnexportedFields bool
+}
+
+// sort slice in descending order by quick sort algorithm.
+// It supports the same types as QuickSort.
+func (s *slice) QuickSortDesc() error {
+	return s.SortWith(SortOptions{Descending: true})
+}
+
+// sort slice with the options.
+// It supports the same types as QuickSort.
+func (s *slice) SortWith(opts SortOptions) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	slicePtrValue := reflect.ValueOf(s.slicePtr)
+	sliceValue := slicePtrValue.Elem()
+	if sliceValue.Len() <= 1 {
+		return nil
+	}
+
+	// the fields and string order are explicit ordering, the others follow the element
+	if len(opts.Fields) == 0 && opts.StringOrder == LexicalOrder {
+		if err = checkSortInterface(sliceValue.Type(), opts.compareFuncName()); err != nil {
+			return err
+		}
+	}
+	compareFunc, err := opts.compareFunc(sliceValue.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	if opts.Stable {
+		buffer := reflect.MakeSlice(sliceValue.Type(), sliceValue.Len(), sliceValue.Len())
+		mergeSort(sliceValue, buffer, 0, sliceValue.Len(), compareFunc)
+	} else {
+		quickSort(sliceValue, 0, sliceValue.Len()-1, compareFunc)
+	}
+	return nil
+}
+
+// return the compare function name of struct
+func (opts SortOptions) compareFuncName() string {
+	if opts.CompareFuncName == "" {
+		return defaultCompareFuncName
+	}
+	return opts.CompareFuncName
+}
+
+// return the function to compare elements with the options
+func (opts SortOptions) compareFunc(elemType reflect.Type) (compareFunc, error) {
+	compareFuncName := opts.compareFuncName()
+
+	valueType := elemType
+	if elemType.Kind() == reflect.Ptr {
+		valueType = elemType.Elem()
+	}
+
+	var compareFunc compareFunc
+	var err error
+	if len(opts.Fields) > 0 {
+		if compareFunc, err = opts.compareFieldsFunc(valueType); err != nil {
+			return nil, err
+		}
+		if valueType != elemType {
+			compareFunc = compareByElem(compareFunc)
+		}
+	} else if valueType.Kind() == reflect.String && opts.StringOrder != LexicalOrder {
+		var compareString func(a, b string) int
+		if compareString, err = stringCompareFunc(opts.StringOrder); err != nil {
+			return nil, err
+		}
+		compareFunc = func(val1, val2 reflect.Value) int {
+			return compareString(val1.String(), val2.String())
+		}
+		if valueType != elemType {
+			compareFunc = compareByElem(compareFunc)
+		}
+	} else if compareFunc, err = elemCompareFunc(elemType, compareFuncName); err != nil {
+		return nil, err
+	}
+
+	if opts.Descending {
+		compareFunc = compareReverse(compareFunc)
+	}
+	if elemType.Kind() == reflect.Ptr {
+		compareFunc = compareNils(compareFunc, opts.NilsLast)
+	}
+	return compareFunc, nil
+}
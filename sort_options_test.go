@@ -0,0 +1,82 @@
+package generic
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestSliceQuickSortDesc(t *testing.T) {
+	values := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	if err := Slice(&values).QuickSortDesc(); err != nil {
+		t.Fatal("Failed to quick sort int slice in descending order! error: ", err)
+	}
+	expected := []int{9, 6, 5, 4, 3, 2, 1, 1}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatal("After quick sort int slice in descending order, the elements should be ordered! actual: ", values)
+		}
+	}
+
+	students := []student{{name: "1", age: 15}, {name: "2", age: 11}, {name: "3", age: 22}}
+	if err := Slice(&students).QuickSortDesc(); err != nil {
+		t.Fatal("Failed to quick sort struct slice in descending order! error: ", err)
+	}
+	if students[0].age != 22 || students[1].age != 15 || students[2].age != 11 {
+		t.Fatal("After quick sort struct slice in descending order, the elements should be ordered! actual: ", students)
+	}
+}
+
+func TestSliceSortWith_StableDesc(t *testing.T) {
+	students := []student{}
+	for i := 0; i < 50; i++ {
+		students = append(students, student{name: strconv.Itoa(i), age: i % 3})
+	}
+	err := Slice(&students).SortWith(SortOptions{Descending: true, Stable: true, CompareFuncName: "CompareByAge"})
+	if err != nil {
+		t.Fatal("Failed to stable sort struct slice in descending order! error: ", err)
+	}
+	for i := 0; i < len(students)-1; i++ {
+		if students[i].age < students[i+1].age {
+			t.Fatal("After stable sort in descending order, the elements should be ordered! actual: ", students)
+		}
+		if students[i].age == students[i+1].age {
+			name1, _ := strconv.Atoi(students[i].name)
+			name2, _ := strconv.Atoi(students[i+1].name)
+			if name1 > name2 {
+				t.Fatal("After stable sort in descending order, the equal elements should keep original order! actual: ", students)
+			}
+		}
+	}
+}
+
+func TestSliceSortWith_NilPlacement(t *testing.T) {
+	students := []*student{nil, {name: "1", age: 15}, nil, {name: "2", age: 11}}
+	if err := Slice(&students).SortWith(SortOptions{NilsLast: true}); err != nil {
+		t.Fatal("Failed to sort struct pointer slice with nils last! error: ", err)
+	}
+	if students[0].age != 11 || students[1].age != 15 || students[2] != nil || students[3] != nil {
+		t.Fatal("After sort with nils last, the nil elements should be last! actual: ", students)
+	}
+
+	if err := Slice(&students).SortWith(SortOptions{Descending: true}); err != nil {
+		t.Fatal("Failed to sort struct pointer slice in descending order! error: ", err)
+	}
+	if students[0] != nil || students[1] != nil || students[2].age != 15 || students[3].age != 11 {
+		t.Fatal("After sort in descending order, the nil elements should still be first! actual: ", students)
+	}
+}
+
+func TestSliceSortWith_StringOrder(t *testing.T) {
+	files := []string{"file10", "file2", "file1"}
+	if err := Slice(&files).SortWith(SortOptions{Descending: true, StringOrder: NaturalOrder}); err != nil {
+		t.Fatal("Failed to sort strings in descending natural order! error: ", err)
+	}
+	if files[0] != "file10" || files[1] != "file2" || files[2] != "file1" {
+		t.Fatal("After sort strings in descending natural order, the elements should be ordered! actual: ", files)
+	}
+
+	values := []map[string]int{{}, {}}
+	if err := Slice(&values).SortWith(SortOptions{}); err == nil {
+		t.Fatal("It should be error when the type is unsupported!")
+	}
+}
@@ -0,0 +1,78 @@
+package generic
+
+import (
+	"reflect"
+	"sort"
+)
+
+// sort slice by merge sort algorithm, the equal elements keep their original order.
+// It supports the same types as QuickSort.
+// The slice type which implements sort.Interface is sorted by sort.Stable.
+func (s *slice) StableSort() error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	if sortable, ok := reflect.ValueOf(s.slicePtr).Elem().Interface().(sort.Interface); ok {
+		sort.Stable(sortable)
+		return nil
+	}
+	return s.StableSortBy(defaultCompareFuncName)
+}
+
+// Basicly it is same as StableSort function.
+// It just give you choice to decide the compare function which is used by struct
+func (s *slice) StableSortBy(compareFuncName string) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	slicePtrValue := reflect.ValueOf(s.slicePtr)
+	sliceValue := slicePtrValue.Elem()
+	if sliceValue.Len() <= 1 {
+		return nil
+	}
+	if err = checkSortInterface(sliceValue.Type(), compareFuncName); err != nil {
+		return err
+	}
+	compareFunc, err := checkTypeOfSort(sliceValue.Type().Elem(), compareFuncName)
+	if err != nil {
+		return err
+	}
+
+	buffer := reflect.MakeSlice(sliceValue.Type(), sliceValue.Len(), sliceValue.Len())
+	mergeSort(sliceValue, buffer, 0, sliceValue.Len(), compareFunc)
+	return nil
+}
+
+// the internal function for implementing merge sort algorithm.
+// sort the elements in [lowIndex, highIndex) of slice, buffer should be as long as slice.
+func mergeSort(slice, buffer reflect.Value, lowIndex, highIndex int, compareFunc compareFunc) {
+	if highIndex-lowIndex <= 1 {
+		return
+	}
+
+	middleIndex := lowIndex + (highIndex-lowIndex)/2
+	mergeSort(slice, buffer, lowIndex, middleIndex, compareFunc)
+	mergeSort(slice, buffer, middleIndex, highIndex, compareFunc)
+
+	// already ordered, nothing to merge
+	if compareFunc(slice.Index(middleIndex-1), slice.Index(middleIndex)) <= 0 {
+		return
+	}
+
+	reflect.Copy(buffer.Slice(lowIndex, highIndex), slice.Slice(lowIndex, highIndex))
+	leftIndex, rightIndex := lowIndex, middleIndex
+	for index := lowIndex; index < highIndex; index++ {
+		// take from left when equal, so that the sort is stable
+		if rightIndex >= highIndex || (leftIndex < middleIndex && compareFunc(buffer.Index(leftIndex), buffer.Index(rightIndex)) <= 0) {
+			slice.Index(index).Set(buffer.Index(leftIndex))
+			leftIndex++
+		} else {
+			slice.Index(index).Set(buffer.Index(rightIndex))
+			rightIndex++
+		}
+	}
+}
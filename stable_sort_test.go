@@ -0,0 +1,71 @@
+package generic
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestSliceStableSort_Struct(t *testing.T) {
+	students := []student{}
+	if err := Slice(&students).StableSort(); err != nil {
+		t.Fatal("Failed to stable sort empty slice! error: ", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		students = append(students, student{name: strconv.Itoa(i), age: (i * 7) % 5})
+	}
+	if err := Slice(&students).StableSort(); err != nil {
+		t.Fatal("Failed to stable sort slice contains many elements! error: ", err)
+	}
+
+	for i := 0; i < len(students)-1; i++ {
+		if students[i].age > students[i+1].age {
+			t.Fatal("After stable sort, the elements should be ordered! actual: ", students)
+		}
+		if students[i].age == students[i+1].age {
+			name1, _ := strconv.Atoi(students[i].name)
+			name2, _ := strconv.Atoi(students[i+1].name)
+			if name1 > name2 {
+				t.Fatal("After stable sort, the equal elements should keep original order! actual: ", students)
+			}
+		}
+	}
+}
+
+func TestSliceStableSort_Int(t *testing.T) {
+	values := []int{5, 3, 9, -1, 3, 0}
+	if err := Slice(&values).StableSort(); err != nil {
+		t.Fatal("Stable sort should support int slice! error: ", err)
+	}
+	expected := []int{-1, 0, 3, 3, 5, 9}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatal("After stable sort int slice, the elements should be ordered! actual: ", values)
+		}
+	}
+
+	values2 := []int{1, 2}
+	if err := Slice(values2).StableSort(); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceStableSortBy(t *testing.T) {
+	students := []student{
+		{name: "a", age: 15},
+		{name: "b", age: 14},
+		{name: "c", age: 15},
+		{name: "d", age: 14},
+	}
+
+	if err := Slice(&students).StableSortBy("CompareByAge"); err != nil {
+		t.Fatal("Failed to stable sort slice by CompareByAge! error: ", err)
+	}
+	if students[0].name != "b" || students[1].name != "d" || students[2].name != "a" || students[3].name != "c" {
+		t.Fatal("After stable sort by CompareByAge, the equal elements should keep original order! actual: ", students)
+	}
+
+	if err := Slice(&students).StableSortBy("CompareByName"); err == nil {
+		t.Fatal("It should be error when the compare function doesn't exist!")
+	}
+}
@@ -0,0 +1,76 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Push elements to the end of slice, it is the same as Append.
+func (s *slice) Push(elems ...interface{}) error {
+	return s.Append(elems...)
+}
+
+// Remove the last element of slice and return it, the vacated element is zeroed.
+func (s *slice) Pop() (interface{}, error) {
+	sliceValue, err := s.checkNotEmpty()
+	if err != nil {
+		return nil, err
+	}
+
+	length := sliceValue.Len()
+	elem := sliceValue.Index(length - 1).Interface()
+	shrink(sliceValue, length-1)
+	return elem, nil
+}
+
+// Remove the first element of slice and return it, the vacated element is zeroed.
+// The slice is resliced instead of moving the elements, so it costs O(1).
+func (s *slice) Shift() (interface{}, error) {
+	sliceValue, err := s.checkNotEmpty()
+	if err != nil {
+		return nil, err
+	}
+
+	first := sliceValue.Index(0)
+	elem := first.Interface()
+	first.Set(reflect.Zero(first.Type()))
+	sliceValue.Set(sliceValue.Slice(1, sliceValue.Len()))
+	return elem, nil
+}
+
+// Insert elements at the beginning of slice, it is the same as Prepend.
+func (s *slice) Unshift(elems ...interface{}) error {
+	return s.Prepend(elems...)
+}
+
+// Return the first element of slice without removing it.
+func (s *slice) Peek() (interface{}, error) {
+	sliceValue, err := s.checkNotEmpty()
+	if err != nil {
+		return nil, err
+	}
+	return sliceValue.Index(0).Interface(), nil
+}
+
+// Return the last element of slice without removing it.
+func (s *slice) PeekLast() (interface{}, error) {
+	sliceValue, err := s.checkNotEmpty()
+	if err != nil {
+		return nil, err
+	}
+	return sliceValue.Index(sliceValue.Len() - 1).Interface(), nil
+}
+
+// check the slice is not empty, and return the slice value
+func (s *slice) checkNotEmpty() (reflect.Value, error) {
+	err := s.checkSlice()
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	sliceValue := reflect.ValueOf(s.slicePtr).Elem()
+	if sliceValue.Len() == 0 {
+		return reflect.Value{}, errors.New("slice is empty!")
+	}
+	return sliceValue, nil
+}
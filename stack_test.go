@@ -0,0 +1,71 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceStack(t *testing.T) {
+	values := []int{}
+	if err := Slice(&values).Push(1, 2); err != nil || !equalSlices(values, []int{1, 2}) {
+		t.Fatal("Failed to push elements! actual: ", values, " error: ", err)
+	}
+	if elem, err := Slice(&values).PeekLast(); err != nil || elem != 2 {
+		t.Fatal("Failed to peek the last element! actual: ", elem, " error: ", err)
+	}
+
+	elem, err := Slice(&values).Pop()
+	if err != nil || elem != 2 || !equalSlices(values, []int{1}) {
+		t.Fatal("Failed to pop element! actual: ", elem, values, " error: ", err)
+	}
+	if tail := values[:2]; tail[1] != 0 {
+		t.Fatal("After pop, the vacated element should be zeroed! actual: ", tail)
+	}
+	if elem, err = Slice(&values).Pop(); err != nil || elem != 1 || len(values) != 0 {
+		t.Fatal("Failed to pop the last element! actual: ", elem, values, " error: ", err)
+	}
+
+	if _, err = Slice(&values).Pop(); err == nil || err.Error() != "slice is empty!" {
+		t.Fatal("It should be error when pop empty slice! error: ", err)
+	}
+	if _, err = Slice(&values).PeekLast(); err == nil {
+		t.Fatal("It should be error when peek empty slice!")
+	}
+	if _, err = Slice(values).Pop(); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceQueue(t *testing.T) {
+	one := &student{name: "1"}
+	students := []*student{}
+	if err := Slice(&students).Push(one, &student{name: "2"}); err != nil {
+		t.Fatal("Failed to push elements! error: ", err)
+	}
+	if elem, err := Slice(&students).Peek(); err != nil || elem != one {
+		t.Fatal("Failed to peek the first element! actual: ", elem, " error: ", err)
+	}
+
+	backing := students
+	elem, err := Slice(&students).Shift()
+	if err != nil || elem != one || len(students) != 1 || students[0].name != "2" {
+		t.Fatal("Failed to shift element! actual: ", elem, students, " error: ", err)
+	}
+	if backing[0] != nil {
+		t.Fatal("After shift, the vacated element should be zeroed!")
+	}
+
+	if err = Slice(&students).Unshift(one); err != nil || len(students) != 2 || students[0] != one {
+		t.Fatal("Failed to unshift element! actual: ", students, " error: ", err)
+	}
+	if err = Slice(&students).Unshift(1); err == nil {
+		t.Fatal("It should be error when element type mismatches!")
+	}
+
+	empty := []int{}
+	if _, err = Slice(&empty).Shift(); err == nil {
+		t.Fatal("It should be error when shift empty slice!")
+	}
+	if _, err = Slice(&empty).Peek(); err == nil {
+		t.Fatal("It should be error when peek empty slice!")
+	}
+}
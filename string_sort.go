@@ -0,0 +1,132 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// The order to compare strings
+type StringOrder int
+
+const (
+	// compare strings byte by byte, it is the default order of QuickSort
+	LexicalOrder StringOrder = iota
+	// compare strings ignoring case, such as "a" < "B" < "c"
+	CaseInsensitiveOrder
+	// compare digits in strings as numbers, such as "file2" < "file10"
+	NaturalOrder
+)
+
+// sort string slice by quick sort algorithm in the order.
+// The slice can be string slice or named string slice, such as []string or []Name which is defined as "type Name string".
+func (s *slice) QuickSortStrings(order StringOrder) error {
+	err := s.checkSlice()
+	if err != nil {
+		return err
+	}
+
+	slicePtrValue := reflect.ValueOf(s.slicePtr)
+	sliceValue := slicePtrValue.Elem()
+	if sliceValue.Type().Elem().Kind() != reflect.String {
+		return errors.New("unsupport type: " + sliceValue.Type().Elem().Kind().String())
+	}
+	if sliceValue.Len() <= 1 {
+		return nil
+	}
+
+	compareString, err := stringCompareFunc(order)
+	if err != nil {
+		return err
+	}
+
+	quickSort(sliceValue, 0, sliceValue.Len()-1, func(val1, val2 reflect.Value) int {
+		return compareString(val1.String(), val2.String())
+	})
+	return nil
+}
+
+// get the function to compare strings in the order
+func stringCompareFunc(order StringOrder) (func(a, b string) int, error) {
+	switch order {
+	case LexicalOrder:
+		return strings.Compare, nil
+	case CaseInsensitiveOrder:
+		return compareCaseInsensitive, nil
+	case NaturalOrder:
+		return compareNatural, nil
+	}
+
+	return nil, errors.New("unsupport string order!")
+}
+
+// compare strings ignoring case.
+// The strings which are equal ignoring case are compared byte by byte, so the order is deterministic.
+func compareCaseInsensitive(a, b string) int {
+	if result := strings.Compare(strings.ToLower(a), strings.ToLower(b)); result != 0 {
+		return result
+	}
+	return strings.Compare(a, b)
+}
+
+// compare strings in natural order, the digit sequences are compared by their numeric value.
+// When two digit sequences have same value, the one with less leading zeros is less, such as "a1" < "a01".
+func compareNatural(a, b string) int {
+	tieBreak := 0
+	for len(a) > 0 && len(b) > 0 {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			digitsA, digitsB := leadingDigits(a), leadingDigits(b)
+			a, b = a[len(digitsA):], b[len(digitsB):]
+
+			trimmedA := strings.TrimLeft(digitsA, "0")
+			trimmedB := strings.TrimLeft(digitsB, "0")
+			if len(trimmedA) != len(trimmedB) {
+				return len(trimmedA) - len(trimmedB)
+			}
+			if result := strings.Compare(trimmedA, trimmedB); result != 0 {
+				return result
+			}
+			if tieBreak == 0 {
+				tieBreak = len(digitsA) - len(digitsB)
+			}
+			continue
+		}
+
+		runeA, sizeA := utf8.DecodeRuneInString(a)
+		runeB, sizeB := utf8.DecodeRuneInString(b)
+		// the invalid UTF-8 is compared byte by byte, so the different bytes are not equal
+		if (runeA == utf8.RuneError && sizeA == 1) || (runeB == utf8.RuneError && sizeB == 1) {
+			if a[0] != b[0] {
+				return int(a[0]) - int(b[0])
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if runeA != runeB {
+			if runeA < runeB {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return tieBreak
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// get the digit sequence at the beginning of the string
+func leadingDigits(str string) string {
+	index := 0
+	for index < len(str) && isDigit(str[index]) {
+		index++
+	}
+	return str[:index]
+}
@@ -0,0 +1,77 @@
+package generic
+
+import (
+	"testing"
+)
+
+type name string
+
+func TestSliceQuickSort_String(t *testing.T) {
+	names := []string{"pear", "apple", "Banana", "apple2", ""}
+	if err := Slice(&names).QuickSort(); err != nil {
+		t.Fatal("Quick sort should support string slice! error: ", err)
+	}
+	expected := []string{"", "Banana", "apple", "apple2", "pear"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatal("After quick sort string slice, the elements should be in lexicographic order! actual: ", names)
+		}
+	}
+
+	namedNames := []name{"b", "c", "a"}
+	if err := Slice(&namedNames).QuickSort(); err != nil {
+		t.Fatal("Quick sort should support named string slice! error: ", err)
+	}
+	if namedNames[0] != "a" || namedNames[1] != "b" || namedNames[2] != "c" {
+		t.Fatal("After quick sort named string slice, the elements should be ordered! actual: ", namedNames)
+	}
+}
+
+func TestSliceQuickSortStrings_CaseInsensitive(t *testing.T) {
+	names := []name{"c", "B", "a", "b"}
+	if err := Slice(&names).QuickSortStrings(CaseInsensitiveOrder); err != nil {
+		t.Fatal("Failed to quick sort strings ignoring case! error: ", err)
+	}
+	expected := []name{"a", "B", "b", "c"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatal("After quick sort strings ignoring case, the elements should be ordered! actual: ", names)
+		}
+	}
+}
+
+func TestSliceQuickSortStrings_Natural(t *testing.T) {
+	files := []string{"file10", "file2", "file1", "file02", "file", "file10a", "file9b"}
+	if err := Slice(&files).QuickSortStrings(NaturalOrder); err != nil {
+		t.Fatal("Failed to quick sort strings in natural order! error: ", err)
+	}
+	expected := []string{"file", "file1", "file2", "file02", "file9b", "file10", "file10a"}
+	for i := range expected {
+		if files[i] != expected[i] {
+			t.Fatal("After quick sort strings in natural order, the elements should be ordered! actual: ", files)
+		}
+	}
+
+	values := []int{2, 1}
+	if err := Slice(&values).QuickSortStrings(NaturalOrder); err == nil {
+		t.Fatal("It should be error when the slice is not string slice!")
+	}
+	if err := Slice(&files).QuickSortStrings(StringOrder(100)); err == nil {
+		t.Fatal("It should be error when the string order is unsupported!")
+	}
+}
+
+func TestSliceQuickSortStrings_NaturalInvalidUTF8(t *testing.T) {
+	if compareNatural("a\xff", "a\xfe") <= 0 || compareNatural("a\xfe", "a\xff") >= 0 || compareNatural("\xff", "\uFFFD") == 0 {
+		t.Fatal("The invalid UTF-8 should be compared byte by byte in natural order!")
+	}
+
+	for _, files := range [][]string{{"a\xff", "a\xfe"}, {"a\xfe", "a\xff"}} {
+		if err := Slice(&files).QuickSortStrings(NaturalOrder); err != nil {
+			t.Fatal("Failed to quick sort invalid UTF-8 strings in natural order! error: ", err)
+		}
+		if files[0] != "a\xfe" || files[1] != "a\xff" {
+			t.Fatal("After quick sort invalid UTF-8 strings in natural order, the elements should be ordered! actual: ", files)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// the tag key to declare the default ordering of struct, such as
+//
+//	type item struct {
+//		Name  string `generic:"sort=2"`
+//		Price int    `generic:"sort=1,desc"`
+//	}
+//
+// the value of sort is the priority of field, the field with less priority is compared first.
+// "desc" means the field is compared in descending order, "asc" is the default.
+const sortTagKey = "generic"
+
+// the sort fields parsed from tags, cache by struct type
+var sortTagCache sync.Map
+
+type sortTagResult struct {
+	fields []string
+	err    error
+}
+
+// make a compareFunc which compares elements by the sort tags of struct, which doesn't have compare function.
+// The tags are only used when the compare function is the default one.
+func compareByTags(structType reflect.Type, compareFuncName string, pointerElem bool) (compareFunc, error) {
+	if compareFuncName != defaultCompareFuncName {
+		return nil, errors.New("no compare function!")
+	}
+
+	fields, err := sortTagFields(structType)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("no compare function!")
+	}
+
+	compareFunc, err := SortOptions{Fields: fields, UnexportedFields: true}.compareFieldsFunc(structType)
+	if err != nil {
+		return nil, err
+	}
+	if pointerElem {
+		return compareByElem(compareFunc), nil
+	}
+	return compareFunc, nil
+}
+
+// get the sort fields declared by tags of struct, the fields are ordered by priority,
+// and the field in descending order has prefix "-", the same as SortOptions.Fields.
+func sortTagFields(structType reflect.Type) ([]string, error) {
+	if result, ok := sortTagCache.Load(structType); ok {
+		return result.(sortTagResult).fields, result.(sortTagResult).err
+	}
+
+	fields, err := parseSortTags(structType)
+	sortTagCache.Store(structType, sortTagResult{fields, err})
+	return fields, err
+}
+
+func parseSortTags(structType reflect.Type) ([]string, error) {
+	type tagField struct {
+		name     string
+		priority int
+	}
+
+	tagFields := []tagField{}
+	priorities := map[int]string{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag, ok := field.Tag.Lookup(sortTagKey)
+		if !ok {
+			continue
+		}
+
+		priority, descending, err := parseSortTag(tag)
+		if err != nil {
+			return nil, errors.New("malformed tag of field " + field.Name + ": " + err.Error())
+		}
+		if other, ok := priorities[priority]; ok {
+			return nil, errors.New("malformed tag of field " + field.Name + ": same sort priority as field " + other)
+		}
+		priorities[priority] = field.Name
+
+		name := field.Name
+		if descending {
+			name = "-" + name
+		}
+		tagFields = append(tagFields, tagField{name, priority})
+	}
+
+	sort.Slice(tagFields, func(i, j int) bool {
+		return tagFields[i].priority < tagFields[j].priority
+	})
+	fields := []string{}
+	for _, field := range tagFields {
+		fields = append(fields, field.name)
+	}
+	return fields, nil
+}
+
+// parse the tag such as "sort=1,desc"
+func parseSortTag(tag string) (priority int, descending bool, err error) {
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		switch {
+		case option == "desc":
+			descending = true
+		case option == "asc":
+			descending = false
+		case strings.HasPrefix(option, "sort="):
+			priority, err = strconv.Atoi(option[len("sort="):])
+			if err != nil || priority <= 0 {
+				return 0, false, errors.New("sort priority should be positive integer: " + option)
+			}
+		default:
+			return 0, false, errors.New("unknown option: " + strconv.Quote(option))
+		}
+	}
+
+	if priority == 0 {
+		return 0, false, errors.New("no sort priority")
+	}
+	return priority, descending, nil
+}
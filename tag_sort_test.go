@@ -0,0 +1,81 @@
+package generic
+
+import (
+	"testing"
+)
+
+type product struct {
+	Name     string `generic:"sort=2"`
+	Price    int    `generic:"sort=1,desc"`
+	category string
+}
+
+type malformedProduct struct {
+	Name  string `generic:"sort=one"`
+	Price int
+}
+
+type duplicatedProduct struct {
+	Name  string `generic:"sort=1"`
+	Price int    `generic:"sort=1,desc"`
+}
+
+type untaggedProduct struct {
+	Name string `json:"name"`
+}
+
+func TestSliceQuickSort_Tags(t *testing.T) {
+	products := []product{
+		{Name: "b", Price: 10},
+		{Name: "c", Price: 20},
+		{Name: "a", Price: 10},
+	}
+	if err := Slice(&products).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort struct slice by tags! error: ", err)
+	}
+	if products[0].Name != "c" || products[1].Name != "a" || products[2].Name != "b" {
+		t.Fatal("After quick sort struct slice by tags, the elements should be ordered! actual: ", products)
+	}
+
+	productPtrs := []*product{{Name: "b", Price: 10}, nil, {Name: "a", Price: 30}}
+	if err := Slice(&productPtrs).StableSort(); err != nil {
+		t.Fatal("Failed to stable sort struct pointer slice by tags! error: ", err)
+	}
+	if productPtrs[0] != nil || productPtrs[1].Name != "a" || productPtrs[2].Name != "b" {
+		t.Fatal("After stable sort struct pointer slice by tags, the elements should be ordered! actual: ", productPtrs)
+	}
+
+	if err := Slice(&products).QuickSortBy("CompareByPrice"); err == nil {
+		t.Fatal("It should be error when the named compare function doesn't exist!")
+	}
+}
+
+func TestSliceQuickSort_MalformedTags(t *testing.T) {
+	malformed := []malformedProduct{{Name: "b"}, {Name: "a"}}
+	if err := Slice(&malformed).QuickSort(); err == nil {
+		t.Fatal("It should be error when the tag is malformed!")
+	}
+
+	duplicated := []duplicatedProduct{{Name: "b"}, {Name: "a"}}
+	if err := Slice(&duplicated).QuickSort(); err == nil {
+		t.Fatal("It should be error when the sort priority is duplicated!")
+	}
+
+	untagged := []untaggedProduct{{Name: "b"}, {Name: "a"}}
+	if err := Slice(&untagged).QuickSort(); err == nil || err.Error() != "no compare function!" {
+		t.Fatal("It should be no compare function error when there is no sort tag! error: ", err)
+	}
+}
+
+func TestParseSortTag(t *testing.T) {
+	priority, descending, err := parseSortTag("sort=3, desc")
+	if err != nil || priority != 3 || !descending {
+		t.Fatal("Failed to parse sort tag! error: ", err)
+	}
+
+	for _, tag := range []string{"", "desc", "sort=0", "sort=-1", "sort=1,", "sort=1,up"} {
+		if _, _, err = parseSortTag(tag); err == nil {
+			t.Fatal("It should be error when parsing malformed tag: ", tag)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package generic
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+type typedSlice[T any] struct {
+	slicePtr *[]T
+}
+
+type comparableSlice[T comparable] struct {
+	typedSlice[T]
+}
+
+type orderedSlice[T cmp.Ordered] struct {
+	comparableSlice[T]
+}
+
+// New a type parameterized slice with slice ptr.
+// The element type is checked by the compiler, so no reflection is needed.
+func SliceOf[T any](slicePtr *[]T) *typedSlice[T] {
+	return &typedSlice[T]{slicePtr}
+}
+
+// New a type parameterized slice whose elements can be compared by ==,
+// it supports Find and Remove besides the functions of SliceOf.
+func ComparableSliceOf[T comparable](slicePtr *[]T) *comparableSlice[T] {
+	return &comparableSlice[T]{typedSlice[T]{slicePtr}}
+}
+
+// New a type parameterized slice whose elements are ordered,
+// it supports QuickSort besides the functions of ComparableSliceOf.
+func OrderedSliceOf[T cmp.Ordered](slicePtr *[]T) *orderedSlice[T] {
+	return &orderedSlice[T]{comparableSlice[T]{typedSlice[T]{slicePtr}}}
+}
+
+// Remove element at index of slice
+func (s *typedSlice[T]) RemoveAt(index int) error {
+	if s.slicePtr == nil {
+		return errors.New("slice is nil!")
+	}
+
+	values := *s.slicePtr
+	if index < 0 || index >= len(values) {
+		return errors.New("index out of range!")
+	}
+
+	copy(values[index:], values[index+1:])
+	var zero T
+	values[len(values)-1] = zero
+	*s.slicePtr = values[:len(values)-1]
+	return nil
+}
+
+// Remove element of slice when equal function return true
+func (s *typedSlice[T]) RemoveBy(equal func(T) bool) error {
+	index, err := s.FindBy(equal)
+	if err != nil {
+		return err
+	}
+
+	if index != -1 {
+		return s.RemoveAt(index)
+	}
+
+	return nil
+}
+
+// Find element of slice when equal function return true
+func (s *typedSlice[T]) FindBy(equal func(T) bool) (int, error) {
+	if s.slicePtr == nil {
+		return -1, errors.New("slice is nil!")
+	}
+
+	for index, value := range *s.slicePtr {
+		if equal(value) {
+			return index, nil
+		}
+	}
+
+	return -1, nil
+}
+
+// Iterate to each element in slice. And then you can do anything in iterate function.
+func (s *typedSlice[T]) ForEach(iterate func(T, int)) error {
+	if s.slicePtr == nil {
+		return errors.New("slice is nil!")
+	}
+
+	for index, value := range *s.slicePtr {
+		iterate(value, index)
+	}
+
+	return nil
+}
+
+// Iterate to each element in slice. It is same as ForEach
+func (s *typedSlice[T]) Each(iterate func(T, int)) error {
+	return s.ForEach(iterate)
+}
+
+// Sort slice by quick sort algorithm with the compare function.
+// return value of compare:
+// if value == 0, a is equal to b,
+// if value < 0, a is less than b,
+// if value > 0, a is greater than b.
+func (s *typedSlice[T]) QuickSortBy(compare func(a, b T) int) error {
+	if s.slicePtr == nil {
+		return errors.New("slice is nil!")
+	}
+
+	if compare == nil {
+		return errors.New("compare function is nil!")
+	}
+
+	// pattern-defeating quick sort, the worst case is O(n*log(n))
+	slices.SortFunc(*s.slicePtr, compare)
+	return nil
+}
+
+// Find element of slice
+func (s *comparableSlice[T]) Find(elem T) (int, error) {
+	return s.FindBy(func(value T) bool {
+		return value == elem
+	})
+}
+
+// Remove element of slice
+func (s *comparableSlice[T]) Remove(elem T) error {
+	index, err := s.Find(elem)
+	if err != nil {
+		return err
+	}
+
+	if index != -1 {
+		return s.RemoveAt(index)
+	}
+
+	return nil
+}
+
+// Sort the elements of slice in ascending order by quick sort algorithm.
+func (s *orderedSlice[T]) QuickSort() error {
+	return s.QuickSortBy(cmp.Compare[T])
+}
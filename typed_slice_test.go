@@ -0,0 +1,109 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestSliceOfRemoveAt(t *testing.T) {
+	values := []byte{1, 2, 3}
+	err := SliceOf(&values).RemoveAt(1)
+	if err != nil || len(values) != 2 || values[0] != 1 || values[1] != 3 {
+		t.Fatal("Failed to RemoveAt middle item of typed slice!")
+	}
+
+	err = SliceOf(&values).RemoveAt(2)
+	if err == nil {
+		t.Fatal("It should be error when removing out of range item of typed slice!")
+	}
+
+	err = SliceOf[byte](nil).RemoveAt(0)
+	if err == nil {
+		t.Fatal("It should be error when the typed slice is nil!")
+	}
+}
+
+func TestSliceOfRemoveBy(t *testing.T) {
+	students := []student{{name: "1", age: 100}, {name: "2", age: 100}, {name: "3", age: 100}}
+	err := SliceOf(&students).RemoveBy(func(elem student) bool {
+		return elem.name == "2"
+	})
+	if err != nil || len(students) != 2 || students[0].name != "1" || students[1].name != "3" {
+		t.Fatal("failed to remove struct from typed slice through RemoveBy!")
+	}
+}
+
+func TestComparableSliceOfFindAndRemove(t *testing.T) {
+	students := []student{{name: "1", age: 100}, {name: "2", age: 100}, {name: "3", age: 100}}
+	index, err := ComparableSliceOf(&students).Find(student{name: "3", age: 100})
+	if err != nil || index != 2 {
+		t.Fatal("failed to find struct from typed slice!")
+	}
+
+	index, err = ComparableSliceOf(&students).Find(student{name: "4", age: 100})
+	if err != nil || index != -1 {
+		t.Fatal("should not find struct which is not in typed slice!")
+	}
+
+	err = ComparableSliceOf(&students).Remove(student{name: "1", age: 100})
+	if err != nil || len(students) != 2 || students[0].name != "2" || students[1].name != "3" {
+		t.Fatal("failed to remove struct from typed slice!")
+	}
+}
+
+func TestSliceOfForEach(t *testing.T) {
+	values := []byte{1, 2, 3}
+	sum := 0
+	err := SliceOf(&values).ForEach(func(elem byte, index int) {
+		sum = sum + int(elem)
+	})
+
+	if err != nil || sum != 6 {
+		t.Fatal("Failed to iterate element of typed slice!")
+	}
+}
+
+func TestOrderedSliceOfQuickSort(t *testing.T) {
+	values := []string{"c", "a", "d", "b"}
+	if err := OrderedSliceOf(&values).QuickSort(); err != nil {
+		t.Fatal("Failed to quick sort typed string slice! error: ", err)
+	}
+	if values[0] != "a" || values[1] != "b" || values[2] != "c" || values[3] != "d" {
+		t.Fatal("After quick sort typed string slice, the elements should be ordered! actual: ", values)
+	}
+
+	students := []student{{name: "3", age: 15}, {name: "4", age: 14}, {name: "5", age: 11}}
+	if err := SliceOf(&students).QuickSortBy(student.Compare); err != nil {
+		t.Fatal("Failed to quick sort typed struct slice! error: ", err)
+	}
+	for i := 0; i < len(students)-1; i++ {
+		if students[i].age > students[i+1].age {
+			t.Fatal("After quick sort typed struct slice, the elements should be ordered! actual: ", students)
+		}
+	}
+}
+
+func TestOrderedSliceOfQuickSort_LargeOrderedInput(t *testing.T) {
+	ascending := make([]int, 50000)
+	descending := make([]int, 50000)
+	for i := range ascending {
+		ascending[i] = i
+		descending[i] = len(descending) - i
+	}
+	for _, values := range [][]int{ascending, descending} {
+		if err := OrderedSliceOf(&values).QuickSort(); err != nil {
+			t.Fatal("Failed to quick sort large ordered typed slice! error: ", err)
+		}
+		for i := 1; i < len(values); i++ {
+			if values[i-1] > values[i] {
+				t.Fatal("After quick sort large ordered typed slice, the elements should be ordered! index: ", i)
+			}
+		}
+	}
+}
+
+func TestSliceOfQuickSortBy_NilCompare(t *testing.T) {
+	values := []int{2, 1}
+	if err := SliceOf(&values).QuickSortBy(nil); err == nil {
+		t.Fatal("It should be error when compare function is nil!")
+	}
+}
@@ -0,0 +1,85 @@
+package generic
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Remove the duplicate elements of slice and keep the first one of them, the order of elements is preserved.
+// Return the count of removed elements, the vacated tail of slice is zeroed.
+// The comparable elements are deduplicated by hashing, the others such as slice and map are compared by reflect.DeepEqual.
+func (s *slice) Unique() (int, error) {
+	seen := newKeySet()
+	return s.removeIf(func(value reflect.Value) bool {
+		return !seen.add(value.Interface())
+	})
+}
+
+// Remove the elements of slice whose key returned by keyFunc is duplicate, and keep the first one of them.
+// The order of elements is preserved. Return the count of removed elements, the vacated tail of slice is zeroed.
+func (s *slice) UniqueBy(keyFunc func(interface{}) interface{}) (int, error) {
+	if keyFunc == nil {
+		return 0, errors.New("key function is nil!")
+	}
+
+	seen := newKeySet()
+	return s.removeIf(func(value reflect.Value) bool {
+		return !seen.add(keyFunc(value.Interface()))
+	})
+}
+
+// Replace the consecutive equal elements of slice with the first one of them, like the uniq command.
+// Return the count of removed elements, the vacated tail of slice is zeroed.
+func (s *slice) Compact() (int, error) {
+	var previous interface{}
+	first := true
+	return s.removeIf(func(value reflect.Value) bool {
+		elem := value.Interface()
+		duplicate := !first && equalKeys(previous, elem)
+		previous, first = elem, false
+		return duplicate
+	})
+}
+
+// the set of keys which have been seen, the comparable keys are hashed,
+// and the others are kept in a list and compared by reflect.DeepEqual.
+type keySet struct {
+	hashed map[interface{}]struct{}
+	others []interface{}
+}
+
+func newKeySet() *keySet {
+	return &keySet{hashed: map[interface{}]struct{}{}}
+}
+
+// add key into the set, return false if the key has been in the set
+func (k *keySet) add(key interface{}) bool {
+	if hashable(key) {
+		if _, ok := k.hashed[key]; ok {
+			return false
+		}
+		k.hashed[key] = struct{}{}
+		return true
+	}
+
+	for _, other := range k.others {
+		if reflect.DeepEqual(other, key) {
+			return false
+		}
+	}
+	k.others = append(k.others, key)
+	return true
+}
+
+// check the key can be used as map key without panic,
+// the struct or array which contains a non-comparable value in interface field is not hashable.
+func hashable(key interface{}) bool {
+	return key == nil || reflect.ValueOf(key).Comparable()
+}
+
+func equalKeys(key1, key2 interface{}) bool {
+	if hashable(key1) && hashable(key2) {
+		return key1 == key2
+	}
+	return reflect.DeepEqual(key1, key2)
+}
@@ -0,0 +1,74 @@
+package generic
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSliceUnique(t *testing.T) {
+	values := []int{3, 1, 3, 2, 1, 3}
+	count, err := Slice(&values).Unique()
+	if err != nil || count != 3 || !equalSlices(values, []int{3, 1, 2}) {
+		t.Fatal("Failed to unique int slice! actual: ", values, " error: ", err)
+	}
+	if tail := values[:6]; tail[3] != 0 || tail[5] != 0 {
+		t.Fatal("After unique, the vacated tail should be zeroed! actual: ", tail)
+	}
+
+	students := []student{{name: "1", age: 10}, {name: "2", age: 11}, {name: "1", age: 10}}
+	count, err = Slice(&students).Unique()
+	if err != nil || count != 1 || len(students) != 2 || students[1].name != "2" {
+		t.Fatal("Failed to unique struct slice! actual: ", students, " error: ", err)
+	}
+
+	empty := []int{}
+	if count, err = Slice(&empty).Unique(); err != nil || count != 0 {
+		t.Fatal("Failed to unique empty slice! error: ", err)
+	}
+	if _, err = Slice(values).Unique(); err == nil {
+		t.Fatal("It should be error when the parameter is slice!")
+	}
+}
+
+func TestSliceUnique_NonComparable(t *testing.T) {
+	values := [][]int{{1, 2}, {3}, {1, 2}, nil, {3}, nil}
+	count, err := Slice(&values).Unique()
+	if err != nil || count != 3 || len(values) != 3 || len(values[0]) != 2 || values[1][0] != 3 || values[2] != nil {
+		t.Fatal("Failed to unique non-comparable slice by deep equality! actual: ", values, " error: ", err)
+	}
+
+	// the interface elements hold both comparable and non-comparable values
+	mixed := []interface{}{1, []int{1}, "a", []int{1}, 1, map[string]int{"a": 1}, map[string]int{"a": 1}, nil, nil}
+	count, err = Slice(&mixed).Unique()
+	if err != nil || count != 4 || len(mixed) != 5 || mixed[2] != "a" || mixed[4] != nil {
+		t.Fatal("Failed to unique interface slice! actual: ", mixed, " error: ", err)
+	}
+}
+
+func TestSliceUniqueBy(t *testing.T) {
+	values := []string{"Go", "rust", "GO", "Rust", "c"}
+	count, err := Slice(&values).UniqueBy(func(value interface{}) interface{} {
+		return strings.ToLower(value.(string))
+	})
+	if err != nil || count != 2 || len(values) != 3 || values[0] != "Go" || values[1] != "rust" || values[2] != "c" {
+		t.Fatal("Failed to unique slice by key function! actual: ", values, " error: ", err)
+	}
+
+	if _, err = Slice(&values).UniqueBy(nil); err == nil {
+		t.Fatal("It should be error when key function is nil!")
+	}
+}
+
+func TestSliceCompact(t *testing.T) {
+	values := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	count, err := Slice(&values).Compact()
+	if err != nil || count != 4 || !equalSlices(values, []int{1, 2, 1, 3}) {
+		t.Fatal("Failed to compact int slice! actual: ", values, " error: ", err)
+	}
+
+	nested := [][]int{{1}, {1}, {2}, {1}}
+	count, err = Slice(&nested).Compact()
+	if err != nil || count != 1 || len(nested) != 3 || nested[1][0] != 2 {
+		t.Fatal("Failed to compact non-comparable slice! actual: ", nested, " error: ", err)
+	}
+}